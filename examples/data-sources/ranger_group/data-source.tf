@@ -0,0 +1,17 @@
+# Example: read the members of a group synchronized from LDAP
+
+data "ranger_group" "analysts" {
+  name = "analysts"
+}
+
+output "analysts_source" {
+  value = data.ranger_group.analysts.group_source
+}
+
+output "analysts_members" {
+  value = data.ranger_group.analysts.users
+}
+
+output "analysts_status" {
+  value = data.ranger_group.analysts.status
+}
@@ -0,0 +1,29 @@
+# Example: Listing Apache Ranger policies with filters
+
+# Every policy that grants the contractors group anything
+data "ranger_policies" "contractors" {
+  group = "contractors"
+}
+
+output "contractor_policies" {
+  description = "Policies with a rule for the contractors group"
+  value = [
+    for policy in data.ranger_policies.contractors.policies : "${policy.service}/${policy.name}"
+  ]
+}
+
+# Enabled Hive policies on the sales database
+data "ranger_policies" "sales" {
+  service_type = "hive"
+  is_enabled   = true
+
+  resources = {
+    database = "sales"
+  }
+}
+
+# Data masking policies of a security zone
+data "ranger_policies" "finance_masking" {
+  zone_name   = "finance"
+  policy_type = 1
+}
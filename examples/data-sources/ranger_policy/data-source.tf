@@ -46,9 +46,9 @@ output "allowed_permissions" {
 output "protected_resources" {
   description = "Resources protected by this policy"
   value = [
-    for resource in data.ranger_policy.hdfs_policy.resources : {
-      type = resource.type
-      paths = resource.values
+    for type, resource in data.ranger_policy.hdfs_policy.resources : {
+      type      = type
+      paths     = resource.values
       recursive = resource.is_recursive
     }
   ]
@@ -0,0 +1,25 @@
+# Example: grant access to a role owned by another team
+
+data "ranger_role" "data_stewards" {
+  name = "data_stewards"
+}
+
+resource "ranger_policy" "sales_stewards" {
+  name    = "sales_stewards"
+  service = "hive_prod"
+
+  resources = {
+    database = {
+      values = ["sales"]
+    }
+  }
+
+  policy_item = [{
+    roles       = [data.ranger_role.data_stewards.name]
+    permissions = ["select"]
+  }]
+}
+
+output "data_steward_admins" {
+  value = [for user in data.ranger_role.data_stewards.users : user.name if user.is_admin]
+}
@@ -0,0 +1,21 @@
+# Example: access review of the roles of a user
+
+data "ranger_roles" "alice" {
+  user = "alice"
+}
+
+# Roles granted to a group
+data "ranger_roles" "finance" {
+  group = "finance"
+}
+
+output "alice_roles" {
+  value = data.ranger_roles.alice.names
+}
+
+output "finance_role_members" {
+  value = {
+    for role in data.ranger_roles.finance.roles :
+    role.name => [for user in coalesce(role.users, []) : user.name]
+  }
+}
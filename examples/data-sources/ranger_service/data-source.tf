@@ -0,0 +1,27 @@
+# Example: Reading an Apache Ranger service
+
+# Look up a service by name
+data "ranger_service" "hive" {
+  name = "hive_prod"
+}
+
+# or by ID
+data "ranger_service" "hdfs" {
+  id = "3"
+}
+
+# Wire a new service to the same tag service as an existing one
+resource "ranger_service" "hive_dev" {
+  name        = "hive_dev"
+  type        = data.ranger_service.hive.type
+  tag_service = data.ranger_service.hive.tag_service
+
+  configs = {
+    username   = "hive"
+    "jdbc.url" = "jdbc:hive2://hive-dev.example.com:10000"
+  }
+}
+
+output "hive_policy_version" {
+  value = data.ranger_service.hive.policy_version
+}
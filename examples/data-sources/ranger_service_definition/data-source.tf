@@ -0,0 +1,22 @@
+# Example: Reading an Apache Ranger service definition
+
+data "ranger_service_definition" "hive" {
+  name = "hive"
+}
+
+# Access types usable in the permissions of hive policies
+output "hive_access_types" {
+  value = [for access_type in data.ranger_service_definition.hive.access_types : access_type.name]
+}
+
+# Resource hierarchy of hive policies
+output "hive_resources" {
+  value = {
+    for resource in data.ranger_service_definition.hive.resources : resource.name => resource.parent
+  }
+}
+
+# Mask types usable in data masking policies
+output "hive_mask_types" {
+  value = [for mask_type in data.ranger_service_definition.hive.data_mask_def.mask_types : mask_type.name]
+}
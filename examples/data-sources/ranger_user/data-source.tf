@@ -0,0 +1,29 @@
+# Example: check that a user synchronized from LDAP exists before granting it access
+
+data "ranger_user" "etl" {
+  name = "svc_etl"
+}
+
+resource "ranger_policy" "staging_etl" {
+  name    = "staging_etl"
+  service = "hive_prod"
+
+  resources = {
+    database = {
+      values = ["staging"]
+    }
+  }
+
+  policy_item = [{
+    users       = [data.ranger_user.etl.name]
+    permissions = ["select", "update"]
+  }]
+}
+
+output "etl_user_source" {
+  value = data.ranger_user.etl.user_source
+}
+
+output "etl_user_groups" {
+  value = data.ranger_user.etl.groups
+}
@@ -20,12 +20,13 @@ resource "ranger_policy" "test" {
   description = "Test policy for verification"
   is_enabled  = true
 
-  resources = [{
-    type        = "path"
-    values      = ["/test/data"]
-    is_exclude  = false
-    is_recursive = true
-  }]
+  resources = {
+    path = {
+      values       = ["/test/data"]
+      is_exclude   = false
+      is_recursive = true
+    }
+  }
 
   policy_item = [{
     users         = []
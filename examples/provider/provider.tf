@@ -6,6 +6,10 @@ provider "ranger" {
   username = "admin"                           # Ranger admin username
   password = var.ranger_password               # Using variable for sensitive value
   insecure = false                             # Set to true to skip TLS verification
+
+  # Fail the plan when a policy grants access to a user, group or role
+  # that does not exist in Ranger
+  validate_principals = true
 }
 
 # Define variables for sensitive information
@@ -23,30 +27,25 @@ resource "ranger_policy" "hdfs_finance_reports" {
   is_enabled  = true
 
   # Define resource scope: finance reports directory
-  resources {
-    type        = "path"
-    values      = ["/data/finance/reports"]
-    is_exclude  = false
-    is_recursive = true  # Apply to subdirectories
-  }
-
-  # Allow rule for finance team
-  policy_item {
-    users         = []
-    groups        = ["finance", "finance_analysts"]
-    roles         = []
-    permissions   = ["read", "write", "execute"]
-    delegate_admin = false
+  resources = {
+    path = {
+      values       = ["/data/finance/reports"]
+      is_recursive = true # Apply to subdirectories
+    }
   }
 
-  # Allow rule for auditors (read-only)
-  policy_item {
-    users         = []
-    groups        = ["auditors"]
-    roles         = []
-    permissions   = ["read", "execute"]
-    delegate_admin = false
-  }
+  policy_item = [
+    # Allow rule for finance team
+    {
+      groups      = ["finance", "finance_analysts"]
+      permissions = ["read", "write", "execute"]
+    },
+    # Allow rule for auditors (read-only)
+    {
+      groups      = ["auditors"]
+      permissions = ["read", "execute"]
+    },
+  ]
 }
 
 # Example of using the data source to reference an existing policy
@@ -0,0 +1,3 @@
+# Groups are imported by name. Groups synchronized by usersync can be
+# imported to read them, but not modified.
+terraform import ranger_group.etl_operators etl_operators
@@ -0,0 +1,23 @@
+# Example: internal group for automation accounts
+
+resource "ranger_group" "etl_operators" {
+  name        = "etl_operators"
+  description = "Service accounts running ETL jobs"
+}
+
+# Policies reference the group by name
+resource "ranger_policy" "etl_staging" {
+  name    = "etl_staging"
+  service = "hive_prod"
+
+  resources = {
+    database = {
+      values = ["staging"]
+    }
+  }
+
+  policy_item = [{
+    groups      = [ranger_group.etl_operators.name]
+    permissions = ["select", "update"]
+  }]
+}
@@ -0,0 +1,2 @@
+# Group memberships are imported by group name, adopting every member
+terraform import ranger_group_membership.etl_operators etl_operators
@@ -0,0 +1,18 @@
+# Example: members of an internal group
+
+resource "ranger_user" "etl" {
+  name = "svc_etl"
+}
+
+# Authoritative: any other member of the group is removed
+resource "ranger_group_membership" "etl_operators" {
+  group         = ranger_group.etl_operators.name
+  users         = [ranger_user.etl.name]
+  authoritative = true
+}
+
+# Additive: only the listed users are managed, other members are left alone
+resource "ranger_group_membership" "analysts_etl" {
+  group = "analysts"
+  users = [ranger_user.etl.name]
+}
@@ -0,0 +1,17 @@
+# Policies can be imported by ID
+terraform import ranger_policy.hive_sales_database 42
+
+# by service and policy name
+terraform import ranger_policy.hive_sales_database hive/sales_database_policy
+
+# or by security zone, service and policy name
+terraform import ranger_policy.finance_reports finance/hive/finance_reports
+
+# Policy names may contain "/", as HDFS path policies often do
+terraform import ranger_policy.finance_data hdfs//data/finance
+
+# Import blocks take the same IDs; import by identity is not supported
+# import {
+#   to = ranger_policy.hive_sales_database
+#   id = "hive/sales_database_policy"
+# }
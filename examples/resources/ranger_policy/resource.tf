@@ -1,116 +1,241 @@
 # Example: Complex Apache Ranger policy for Hive service
 
 resource "ranger_policy" "hive_sales_database" {
-  name           = "sales_database_policy"
-  service        = "hive"
-  description    = "Policy controlling access to the sales database and tables"
-  is_enabled     = true
+  name             = "sales_database_policy"
+  service          = "hive"
+  description      = "Policy controlling access to the sales database and tables"
+  is_enabled       = true
   is_audit_enabled = true
-  policy_type    = 0  # Access policy (0 is the default)
-
-  # Resource definition for the database
-  resources {
-    type         = "database"
-    values       = ["sales"]
-    is_exclude   = false
-    is_recursive = false
-  }
-  
-  # Resource definition for tables
-  resources {
-    type         = "table"
-    values       = ["transactions", "customers", "products"]
-    is_exclude   = false
-    is_recursive = false
-  }
-  
-  # Resource definition for columns
-  resources {
-    type         = "column"
-    values       = ["*"]  # All columns
-    is_exclude   = false
-    is_recursive = false
-  }
+  policy_type      = 0 # Access policy (0 is the default)
 
-  # Allow rule for data scientists (read-only access)
-  policy_item {
-    groups       = ["data_scientists"]
-    users        = []
-    roles        = []
-    permissions  = ["SELECT"]
-    delegate_admin = false
-  }
-
-  # Allow rule for sales analysts (more privileges)
-  policy_item {
-    groups       = ["sales_analysts"]
-    users        = []
-    roles        = []
-    permissions  = ["SELECT", "UPDATE", "CREATE", "DROP", "ALTER", "INDEX", "LOCK"]
-    delegate_admin = false
+  # Resource components are keyed by their type
+  resources = {
+    database = {
+      values = ["sales"]
+    }
+    table = {
+      values = ["transactions", "customers", "products"]
+    }
+    column = {
+      values = ["*"] # All columns
+    }
   }
 
-  # Allow rule for specific admin users (full access)
-  policy_item {
-    groups       = []
-    users        = ["admin1", "admin2"]
-    roles        = []
-    permissions  = ["SELECT", "UPDATE", "CREATE", "DROP", "ALTER", "INDEX", "LOCK", "ALL"]
-    delegate_admin = true  # Can delegate these permissions
-  }
+  policy_item = [
+    # Allow rule for data scientists (read-only access)
+    {
+      groups      = ["data_scientists"]
+      permissions = ["select"]
+    },
+    # Allow rule for sales analysts (more privileges)
+    {
+      groups      = ["sales_analysts"]
+      permissions = ["select", "update", "create", "drop", "alter", "index", "lock"]
+    },
+    # Allow rule for specific admin users (full access)
+    {
+      users          = ["admin1", "admin2"]
+      permissions    = ["select", "update", "create", "drop", "alter", "index", "lock", "all"]
+      delegate_admin = true # Can delegate these permissions
+    },
+    # Example with conditions. The condition types must be defined by the
+    # service definition, or the plan fails
+    {
+      groups      = ["weekend_batch_jobs"]
+      permissions = ["select", "update", "create"]
+      condition = [
+        {
+          type   = "ip-range"
+          values = ["10.0.0.0/8"]
+        },
+        {
+          type   = "_expression"
+          values = ["IS_IN_GROUP('batch_operators')"]
+        },
+      ]
+    },
+  ]
 
   # Deny rule for temporary contractors
-  deny_item {
-    groups       = ["temp_contractors"]
-    users        = []
-    roles        = []
-    permissions  = ["DROP", "ALTER"]  # Prevent schema modifications
-    delegate_admin = false
+  deny_item = [
+    {
+      groups      = ["temp_contractors"]
+      permissions = ["drop", "alter"] # Prevent schema modifications
+    },
+  ]
+}
+
+# Example: Policy with row-level filtering
+resource "ranger_policy" "hive_filtered_sales" {
+  name        = "filtered_sales_data_policy"
+  service     = "hive"
+  description = "Row-filtered access to sales data by region"
+  is_enabled  = true
+  policy_type = 2 # Row-filter policy type
+
+  resources = {
+    database = {
+      values = ["sales"]
+    }
+    table = {
+      values = ["regional_sales"]
+    }
   }
 
-  # Example with time-based condition (if supported by your Ranger installation)
-  policy_item {
-    groups       = ["weekend_batch_jobs"]
-    users        = []
-    roles        = []
-    permissions  = ["SELECT", "UPDATE", "CREATE"]
-    delegate_admin = false
-    conditions   = {
-      "time" = ["weekends"]  # If your Ranger supports time-based conditions
+  row_filter_item = [
+    {
+      groups      = ["north_region_users"]
+      permissions = ["select"]
+      row_filter_info = {
+        filter_expr = "region = 'NORTH'"
+      }
+    },
+  ]
+}
+
+# Example: Data masking policy for PII columns
+resource "ranger_policy" "hive_customer_pii_masking" {
+  name        = "customer_pii_masking"
+  service     = "hive"
+  description = "Mask customer PII for everyone but the privacy team"
+  policy_type = 1 # Data-mask policy type
+
+  resources = {
+    database = {
+      values = ["sales"]
+    }
+    table = {
+      values = ["customers"]
+    }
+    column = {
+      values = ["ssn"]
     }
   }
+
+  data_mask_item = [
+    {
+      groups      = ["privacy_team"]
+      permissions = ["select"]
+      data_mask_info = {
+        data_mask_type = "MASK_NONE"
+      }
+    },
+    {
+      groups      = ["analysts"]
+      permissions = ["select"]
+      data_mask_info = {
+        data_mask_type = "MASK_SHOW_LAST_4"
+      }
+    },
+    {
+      groups      = ["public"]
+      permissions = ["select"]
+      data_mask_info = {
+        data_mask_type = "CUSTOM"
+        value_expr     = "concat('***-**-', substr({col}, -4))"
+      }
+    },
+  ]
 }
 
-# Example: Policy with row-level filtering (if supported)
-resource "ranger_policy" "hive_filtered_sales" {
-  name           = "filtered_sales_data_policy"
-  service        = "hive"
-  description    = "Row-filtered access to sales data by region"
-  is_enabled     = true
-  policy_type    = 2  # Row-filter policy type
-
-  # Resource for row-filtered table
-  resources {
-    type         = "database"
-    values       = ["sales"]
-    is_exclude   = false
-    is_recursive = false
+# Example: Read access to everything except the secure area
+resource "ranger_policy" "hdfs_everything_except_secure" {
+  name    = "everything_except_secure"
+  service = "hdfs"
+
+  resources = {
+    path = {
+      values       = ["/data/secure"]
+      is_exclude   = true
+      is_recursive = true
+    }
   }
-  
-  resources {
-    type         = "table"
-    values       = ["regional_sales"]
-    is_exclude   = false
-    is_recursive = false
+
+  policy_item = [
+    {
+      groups      = ["analysts"]
+      permissions = ["read", "execute"]
+    },
+  ]
+}
+
+# Example: Allow a group except for some of its members
+resource "ranger_policy" "hdfs_analytics_except_contractors" {
+  name    = "analytics_except_contractors"
+  service = "hdfs"
+
+  resources = {
+    path = {
+      values       = ["/data/analytics"]
+      is_recursive = true
+    }
   }
 
-  # Grant rule with row filter (specifics depend on your Ranger version)
-  policy_item {
-    groups       = ["north_region_users"]
-    users        = []
-    roles        = []
-    permissions  = ["SELECT"]
-    delegate_admin = false
-    # Conditions would be used to define the row filter in a real implementation
+  policy_item = [
+    {
+      groups      = ["analysts"]
+      permissions = ["read", "execute"]
+    },
+  ]
+
+  allow_exception = [
+    {
+      users       = ["contractor1", "contractor2"]
+      permissions = ["read", "execute"]
+    },
+  ]
+}
+
+# Example: Temporary audit access that expires on its own
+resource "ranger_policy" "hive_audit_temporary" {
+  name        = "q1_audit_temporary_access"
+  service     = "hive"
+  description = "Read access for the Q1 external audit"
+
+  resources = {
+    database = {
+      values = ["finance"]
+    }
+    table = {
+      values = ["*"]
+    }
+    column = {
+      values = ["*"]
+    }
   }
-} 
\ No newline at end of file
+
+  policy_item = [
+    {
+      groups      = ["external_auditors"]
+      permissions = ["select"]
+    },
+  ]
+
+  validity_schedule = [
+    {
+      start_time = "2025-04-01T00:00:00Z"
+      end_time   = "2025-04-30T23:59:59Z"
+
+      # Only during office hours on weekdays
+      recurrence = [
+        {
+          schedule = {
+            minute      = "0"
+            hour        = "9"
+            day_of_week = "2-6"
+          }
+          interval = {
+            hours = 8
+          }
+        },
+      ]
+    },
+  ]
+}
+
+# Example: Adopt an existing policy with an import block (Terraform 1.5+),
+# identified by service and policy name
+import {
+  to = ranger_policy.hive_sales_database
+  id = "hive/sales_database_policy"
+}
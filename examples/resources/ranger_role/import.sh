@@ -0,0 +1,2 @@
+# Roles are imported by name
+terraform import ranger_role.data_stewards data_stewards
@@ -0,0 +1,37 @@
+# Example: role granting access to data stewards
+
+resource "ranger_role" "data_stewards" {
+  name        = "data_stewards"
+  description = "Curate the sales data products"
+
+  users = [
+    { name = "alice", is_admin = true },
+    { name = "bob" },
+  ]
+
+  groups = [
+    { name = "stewards" },
+  ]
+
+  # Roles can contain other roles
+  roles = [
+    { name = "data_owners" },
+  ]
+}
+
+# Policies grant access to the role by name
+resource "ranger_policy" "sales_stewards" {
+  name    = "sales_stewards"
+  service = "hive_prod"
+
+  resources = {
+    database = {
+      values = ["sales"]
+    }
+  }
+
+  policy_item = [{
+    roles       = [ranger_role.data_stewards.name]
+    permissions = ["select", "update", "alter"]
+  }]
+}
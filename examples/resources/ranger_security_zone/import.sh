@@ -0,0 +1,2 @@
+# Security zones are imported by name
+terraform import ranger_security_zone.finance finance
@@ -0,0 +1,47 @@
+# Example: security zone delegating the finance data to its business unit
+
+resource "ranger_security_zone" "finance" {
+  name        = "finance"
+  description = "Finance data lake"
+
+  services = {
+    hive_prod = {
+      resources = [
+        {
+          database = ["finance", "finance_*"]
+          table    = ["*"]
+          column   = ["*"]
+        },
+      ]
+    }
+    hdfs_prod = {
+      resources = [
+        { path = ["/data/finance"] },
+      ]
+    }
+  }
+
+  tag_services = ["tags_prod"]
+
+  admin_users       = ["alice"]
+  admin_user_groups = ["finance_admins"]
+  audit_user_groups = ["auditors"]
+}
+
+# Policies join the zone through zone_name
+resource "ranger_policy" "finance_analysts" {
+  name      = "finance_analysts"
+  service   = "hive_prod"
+  zone_name = ranger_security_zone.finance.name
+
+  resources = {
+    database = {
+      values = ["finance"]
+    }
+  }
+
+  policy_item = [{
+    groups      = ["finance_analysts"]
+    permissions = ["select"]
+  }]
+}
@@ -0,0 +1,6 @@
+# Services are imported by name
+terraform import ranger_service.hive hive_prod
+
+# No configs are tracked after import, so the first apply records the
+# configured ones. Configs Ranger added itself, such as audit filters, are
+# kept and never show up as removals.
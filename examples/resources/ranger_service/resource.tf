@@ -0,0 +1,37 @@
+# Example: Hive service (repository) managed by Terraform
+
+resource "ranger_service" "hive" {
+  name        = "hive_prod"
+  type        = "hive"
+  description = "Production Hive warehouse"
+  is_enabled  = true
+  tag_service = "tags_prod"
+
+  configs = {
+    "username"             = "hive"
+    "jdbc.driverClassName" = "org.apache.hive.jdbc.HiveDriver"
+    "jdbc.url"             = "jdbc:hive2://hive.example.com:10000"
+  }
+
+  # Ranger masks password configs, keep them out of plan output
+  sensitive_configs = {
+    "password" = var.hive_password
+  }
+}
+
+# Policies reference the service by name instead of a hardcoded string
+resource "ranger_policy" "hive_sales" {
+  name    = "sales_database_policy"
+  service = ranger_service.hive.name
+
+  resources = {
+    database = {
+      values = ["sales"]
+    }
+  }
+
+  policy_item = [{
+    groups      = ["sales_analysts"]
+    permissions = ["select"]
+  }]
+}
@@ -0,0 +1,2 @@
+# Service definitions are imported by name
+terraform import ranger_service_definition.kafka_connect kafka-connect
@@ -0,0 +1,87 @@
+# Example: service type of a custom Ranger plugin
+
+resource "ranger_service_definition" "kafka_connect" {
+  name         = "kafka-connect"
+  display_name = "Kafka Connect"
+  impl_class   = "org.example.ranger.services.kafkaconnect.RangerServiceKafkaConnect"
+  description  = "Kafka Connect clusters"
+
+  configs = [
+    {
+      item_id   = 1
+      name      = "connect.url"
+      type      = "string"
+      mandatory = true
+      label     = "Connect REST URL"
+    },
+    {
+      item_id  = 2
+      name     = "auth.type"
+      type     = "enum"
+      sub_type = "authType"
+      label    = "Authentication"
+    },
+  ]
+
+  enums = [{
+    item_id = 1
+    name    = "authType"
+    elements = [
+      { item_id = 1, name = "simple", label = "Simple" },
+      { item_id = 2, name = "kerberos", label = "Kerberos" },
+    ]
+  }]
+
+  # Connectors belong to a cluster, tasks to a connector
+  resources = [
+    {
+      item_id          = 1
+      name             = "cluster"
+      type             = "string"
+      level            = 10
+      mandatory        = true
+      lookup_supported = true
+      matcher          = "org.apache.ranger.plugin.resourcematcher.RangerDefaultResourceMatcher"
+      matcher_options = {
+        wildCard   = "true"
+        ignoreCase = "true"
+      }
+    },
+    {
+      item_id            = 2
+      name               = "connector"
+      type               = "string"
+      level              = 20
+      parent             = "cluster"
+      excludes_supported = true
+      matcher            = "org.apache.ranger.plugin.resourcematcher.RangerDefaultResourceMatcher"
+      matcher_options = {
+        wildCard = "true"
+      }
+    },
+  ]
+
+  access_types = [
+    { item_id = 1, name = "view", label = "View" },
+    { item_id = 2, name = "restart", label = "Restart" },
+    { item_id = 3, name = "admin", label = "Admin", implied_grants = ["view", "restart"] },
+  ]
+
+  policy_conditions = [{
+    item_id   = 1
+    name      = "ip-range"
+    label     = "IP Address Range"
+    evaluator = "org.apache.ranger.plugin.conditionevaluator.RangerIpMatcher"
+  }]
+}
+
+# Services of the custom type refer to the service definition by name
+resource "ranger_service" "kafka_connect" {
+  name = "kafka_connect_prod"
+  type = ranger_service_definition.kafka_connect.name
+
+  configs = {
+    "connect.url" = "https://connect.example.com:8083"
+    "auth.type"   = "kerberos"
+  }
+}
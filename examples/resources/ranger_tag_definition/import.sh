@@ -0,0 +1,2 @@
+# Tag definitions are imported by name
+terraform import ranger_tag_definition.pii PII
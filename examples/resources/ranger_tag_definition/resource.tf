@@ -0,0 +1,37 @@
+# Example: deny access to PII-tagged data outside the privacy team
+
+resource "ranger_tag_definition" "pii" {
+  name = "PII"
+
+  attribute_defs = [
+    {
+      name = "expiry_date"
+      type = "date"
+    },
+    {
+      name = "classification"
+      type = "string"
+    },
+  ]
+}
+
+resource "ranger_policy" "pii_deny" {
+  name    = "pii_deny"
+  service = "tags" # A service of type "tag"
+
+  resources = {
+    tag = {
+      values = [ranger_tag_definition.pii.name]
+    }
+  }
+
+  deny_item = [{
+    groups      = ["public"]
+    permissions = ["hive:select", "hive:update"]
+  }]
+
+  deny_exception = [{
+    groups      = ["privacy_officers"]
+    permissions = ["hive:select"]
+  }]
+}
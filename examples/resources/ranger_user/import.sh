@@ -0,0 +1,2 @@
+# Users are imported by name
+terraform import ranger_user.etl svc_etl
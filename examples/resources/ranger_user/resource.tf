@@ -0,0 +1,38 @@
+# Example: internal service account for a batch job
+
+variable "etl_password" {
+  type      = string
+  sensitive = true
+  ephemeral = true
+}
+
+resource "ranger_user" "etl" {
+  name        = "svc_etl"
+  first_name  = "ETL"
+  last_name   = "Service Account"
+  description = "Nightly ETL jobs"
+
+  # Write-only: sent to Ranger but never stored in state. Bump
+  # password_version to rotate it.
+  password         = var.etl_password
+  password_version = 1
+
+  user_role_list = ["ROLE_USER"]
+}
+
+# Policies reference the user by name
+resource "ranger_policy" "etl_staging" {
+  name    = "etl_staging"
+  service = "hive_prod"
+
+  resources = {
+    database = {
+      values = ["staging"]
+    }
+  }
+
+  policy_item = [{
+    users       = [ranger_user.etl.name]
+    permissions = ["select", "update"]
+  }]
+}
@@ -0,0 +1,123 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// parseInt64 parses the string IDs of Terraform state as Ranger IDs.
+func parseInt64(s string) (int64, error) {
+	var i int64
+	_, err := fmt.Sscanf(s, "%d", &i)
+	return i, err
+}
+
+// stringValues converts strings to Terraform values, returning nil for an
+// empty slice so the attribute is null rather than empty.
+func stringValues(values []string) []types.String {
+	if len(values) == 0 {
+		return nil
+	}
+
+	result := make([]types.String, 0, len(values))
+	for _, value := range values {
+		result = append(result, types.StringValue(value))
+	}
+	return result
+}
+
+// stringMapValues converts a map of strings to Terraform values, returning
+// nil for an empty map so the attribute is null rather than empty.
+func stringMapValues(values map[string]string) map[string]types.String {
+	if len(values) == 0 {
+		return nil
+	}
+
+	result := make(map[string]types.String, len(values))
+	for key, value := range values {
+		result[key] = types.StringValue(value)
+	}
+	return result
+}
+
+// stringValueOrNull maps the empty strings Ranger returns for unset fields to null.
+func stringValueOrNull(s string) types.String {
+	if s == "" {
+		return types.StringNull()
+	}
+	return types.StringValue(s)
+}
+
+// valueStrings converts Terraform values to strings.
+func valueStrings(values []types.String) []string {
+	if len(values) == 0 {
+		return nil
+	}
+
+	result := make([]string, 0, len(values))
+	for _, value := range values {
+		result = append(result, value.ValueString())
+	}
+	return result
+}
+
+// stringMap converts a map of Terraform values to strings.
+func stringMap(values map[string]types.String) map[string]string {
+	if len(values) == 0 {
+		return nil
+	}
+
+	result := make(map[string]string, len(values))
+	for key, value := range values {
+		result[key] = value.ValueString()
+	}
+	return result
+}
+
+// knownStrings returns the known elements of a set of strings.
+func knownStrings(value attr.Value) []types.String {
+	set, ok := value.(types.Set)
+	if !ok {
+		return nil
+	}
+
+	var values []types.String
+	for _, element := range set.Elements() {
+		if s, ok := element.(types.String); ok && !s.IsNull() && !s.IsUnknown() {
+			values = append(values, s)
+		}
+	}
+	return values
+}
+
+// containsString reports whether values contains s.
+func containsString(values []string, s string) bool {
+	for _, value := range values {
+		if value == s {
+			return true
+		}
+	}
+	return false
+}
+
+// quotedList returns the values sorted, quoted and comma-separated, for use
+// in error messages.
+func quotedList(values []string) string {
+	if len(values) == 0 {
+		return "none"
+	}
+
+	quoted := make([]string, 0, len(values))
+	for _, value := range values {
+		quoted = append(quoted, fmt.Sprintf("%q", value))
+	}
+	sort.Strings(quoted)
+	return strings.Join(quoted, ", ")
+}
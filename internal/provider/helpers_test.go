@@ -0,0 +1,52 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	datasourceschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	resourceschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// testResourcePlan returns a plan of the resource holding the model.
+func testResourcePlan(t *testing.T, r resource.Resource, model any) tfsdk.Plan {
+	t.Helper()
+
+	ctx := context.Background()
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+
+	plan := tfsdk.Plan{Schema: schemaResp.Schema}
+	if diags := plan.Set(ctx, model); diags.HasError() {
+		t.Fatalf("could not set plan: %v", diags)
+	}
+	return plan
+}
+
+// assertModelFitsSchema fails the test unless model can be set as the state
+// of a resource or data source with the schema.
+func assertModelFitsSchema(t *testing.T, schema any, model any) {
+	t.Helper()
+
+	ctx := context.Background()
+	var state tfsdk.State
+	switch schema := schema.(type) {
+	case resourceschema.Schema:
+		state.Schema = schema
+	case datasourceschema.Schema:
+		state.Schema = schema
+	default:
+		t.Fatalf("unexpected schema type %T", schema)
+	}
+
+	state.Raw = tftypes.NewValue(state.Schema.Type().TerraformType(ctx), nil)
+	if diags := state.Set(ctx, model); diags.HasError() {
+		t.Fatalf("model does not fit the schema: %v", diags)
+	}
+}
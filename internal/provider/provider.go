@@ -5,11 +5,6 @@ package provider
 
 import (
 	"context"
-	"crypto/tls"
-	"encoding/base64"
-	"fmt"
-	"net/http"
-	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
@@ -19,6 +14,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-scaffolding-framework/internal/ranger"
 )
 
 // Ensure RangerProvider satisfies various provider interfaces.
@@ -36,19 +32,21 @@ type RangerProvider struct {
 
 // RangerProviderModel describes the provider data model.
 type RangerProviderModel struct {
-	Endpoint types.String `tfsdk:"endpoint"`
-	Username types.String `tfsdk:"username"`
-	Password types.String `tfsdk:"password"`
-	Insecure types.Bool   `tfsdk:"insecure"`
+	Endpoint           types.String `tfsdk:"endpoint"`
+	Username           types.String `tfsdk:"username"`
+	Password           types.String `tfsdk:"password"`
+	Insecure           types.Bool   `tfsdk:"insecure"`
+	ValidatePrincipals types.Bool   `tfsdk:"validate_principals"`
 }
 
-// RangerClient is the client for interacting with the Apache Ranger API
+// RangerClient is the client for interacting with the Apache Ranger API. It is
+// passed to resources and data sources as provider data.
 type RangerClient struct {
-	Endpoint   string
-	Username   string
-	Password   string
-	Client     *http.Client
-	AuthHeader string
+	*ranger.Client
+
+	// ValidatePrincipals makes ranger_policy check during plan that the
+	// principals of its items exist.
+	ValidatePrincipals bool
 }
 
 func (p *RangerProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -78,6 +76,12 @@ func (p *RangerProvider) Schema(ctx context.Context, req provider.SchemaRequest,
 				MarkdownDescription: "Boolean to disable TLS certificate verification, if using self-signed certs on the Ranger endpoint (default `false`)",
 				Optional:            true,
 			},
+			"validate_principals": schema.BoolAttribute{
+				MarkdownDescription: "Check during plan that every user, group and role granted access by a `ranger_policy` exists in Ranger (default `false`). " +
+					"Ranger silently ignores unknown principals, so a typo otherwise leaves a policy granting nothing. " +
+					"Principals created in the same apply must already exist when planning, so enable this once they are in place",
+				Optional: true,
+			},
 		},
 	}
 }
@@ -120,31 +124,15 @@ func (p *RangerProvider) Configure(ctx context.Context, req provider.ConfigureRe
 		return
 	}
 
-	// Create HTTP client with optional TLS verification disabled
-	tlsConfig := &tls.Config{}
-	transport := &http.Transport{}
-
-	if !data.Insecure.IsNull() && data.Insecure.ValueBool() {
-		tlsConfig.InsecureSkipVerify = true
-		transport.TLSClientConfig = tlsConfig
-	}
-
-	client := &http.Client{
-		Transport: transport,
-	}
-
-	// Create Basic Auth header
-	authString := fmt.Sprintf("%s:%s", data.Username.ValueString(), data.Password.ValueString())
-	encodedAuth := base64.StdEncoding.EncodeToString([]byte(authString))
-	authHeader := fmt.Sprintf("Basic %s", encodedAuth)
-
 	// Create Ranger client
 	rangerClient := &RangerClient{
-		Endpoint:   strings.TrimSuffix(data.Endpoint.ValueString(), "/"),
-		Username:   data.Username.ValueString(),
-		Password:   data.Password.ValueString(),
-		Client:     client,
-		AuthHeader: authHeader,
+		Client: ranger.NewClient(ranger.Config{
+			Endpoint: data.Endpoint.ValueString(),
+			Username: data.Username.ValueString(),
+			Password: data.Password.ValueString(),
+			Insecure: data.Insecure.ValueBool(),
+		}),
+		ValidatePrincipals: data.ValidatePrincipals.ValueBool(),
 	}
 
 	resp.DataSourceData = rangerClient
@@ -154,6 +142,14 @@ func (p *RangerProvider) Configure(ctx context.Context, req provider.ConfigureRe
 func (p *RangerProvider) Resources(ctx context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
 		NewRangerPolicyResource,
+		NewRangerServiceResource,
+		NewRangerServiceDefinitionResource,
+		NewRangerUserResource,
+		NewRangerGroupResource,
+		NewRangerGroupMembershipResource,
+		NewRangerRoleResource,
+		NewRangerSecurityZoneResource,
+		NewRangerTagDefinitionResource,
 	}
 }
 
@@ -164,6 +160,13 @@ func (p *RangerProvider) EphemeralResources(ctx context.Context) []func() epheme
 func (p *RangerProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
 		NewRangerPolicyDataSource,
+		NewRangerPoliciesDataSource,
+		NewRangerServiceDataSource,
+		NewRangerServiceDefinitionDataSource,
+		NewRangerRoleDataSource,
+		NewRangerRolesDataSource,
+		NewRangerUserDataSource,
+		NewRangerGroupDataSource,
 	}
 }
 
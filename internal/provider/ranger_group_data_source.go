@@ -0,0 +1,156 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-scaffolding-framework/internal/ranger"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &RangerGroupDataSource{}
+
+// NewRangerGroupDataSource creates a new data source for Ranger groups.
+func NewRangerGroupDataSource() datasource.DataSource {
+	return &RangerGroupDataSource{}
+}
+
+// RangerGroupDataSource defines the data source implementation.
+type RangerGroupDataSource struct {
+	client *RangerClient
+}
+
+// RangerGroupDataSourceModel describes the data source data model.
+type RangerGroupDataSourceModel struct {
+	ID          types.String   `tfsdk:"id"`
+	Name        types.String   `tfsdk:"name"`
+	Description types.String   `tfsdk:"description"`
+	GroupSource types.String   `tfsdk:"group_source"`
+	Status      types.String   `tfsdk:"status"`
+	Users       []types.String `tfsdk:"users"`
+}
+
+// groupStatuses maps the status values to Ranger's group visibility.
+var groupStatuses = map[string]int64{
+	"visible": ranger.GroupVisible,
+	"hidden":  ranger.GroupHidden,
+}
+
+// groupStatusName returns the status value of a Ranger group visibility.
+func groupStatusName(visibility int64) string {
+	for name, value := range groupStatuses {
+		if value == visibility {
+			return name
+		}
+	}
+	return fmt.Sprintf("%d", visibility)
+}
+
+// Metadata returns the data source type name.
+func (d *RangerGroupDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_group"
+}
+
+// Schema defines the schema for the data source.
+func (d *RangerGroupDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Retrieve an existing Apache Ranger group and its member users. Reading a group that does not exist fails, so the data source can check that a principal exists",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The internal ID of the group in Apache Ranger",
+				Computed:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the group",
+				Required:            true,
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "A human-readable description of the group",
+				Computed:            true,
+			},
+			"group_source": schema.StringAttribute{
+				MarkdownDescription: "Where the group comes from: `internal` for groups created in Ranger, `external` for groups synchronized by usersync from LDAP/AD or Unix",
+				Computed:            true,
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "Whether the group is offered in the Ranger Admin UI: `visible` or `hidden`",
+				Computed:            true,
+			},
+			"users": schema.SetAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "The names of the member users",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *RangerGroupDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*RangerClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *RangerClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+// Read reads the data source.
+func (d *RangerGroupDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data RangerGroupDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading Ranger group", map[string]interface{}{
+		"name": data.Name.ValueString(),
+	})
+
+	groupUsers, err := d.client.GetGroupUsers(ctx, data.Name.ValueString())
+	if ranger.IsNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Ranger Group Not Found",
+			fmt.Sprintf("No group found with name %q", data.Name.ValueString()),
+		)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Ranger Group",
+			fmt.Sprintf("Could not read group %q: %s", data.Name.ValueString(), err),
+		)
+		return
+	}
+
+	group := groupUsers.Group
+	data = RangerGroupDataSourceModel{
+		ID:          types.StringValue(fmt.Sprintf("%d", group.ID)),
+		Name:        types.StringValue(group.Name),
+		Description: stringValueOrNull(group.Description),
+		GroupSource: types.StringValue(principalSourceName(group.GroupSource)),
+		Status:      types.StringValue(groupStatusName(group.IsVisible)),
+		Users:       []types.String{},
+	}
+	for _, user := range groupUsers.Users {
+		data.Users = append(data.Users, types.StringValue(user.Name))
+	}
+
+	// Set the state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,35 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"net/http"
+	"testing"
+)
+
+func TestGroupDataSourceRead(t *testing.T) {
+	resp := testDataSourceRead(t, &RangerGroupDataSource{}, "analysts", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/service/xusers/groupusers/groupName/analysts" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = w.Write([]byte(`{"xgroupInfo":{"id":4,"name":"analysts","groupSource":0,"isVisible":1},"xuserInfo":[{"id":5,"name":"alice"},{"id":6,"name":"bob"}]}`))
+	})
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected errors: %v", resp.Diagnostics)
+	}
+
+	var data RangerGroupDataSourceModel
+	resp.Diagnostics.Append(resp.State.Get(context.Background(), &data)...)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("could not read state: %v", resp.Diagnostics)
+	}
+	if data.ID.ValueString() != "4" || data.GroupSource.ValueString() != "internal" || data.Status.ValueString() != "visible" {
+		t.Errorf("unexpected group: %+v", data)
+	}
+	if len(data.Users) != 2 || data.Users[0].ValueString() != "alice" {
+		t.Errorf("unexpected members: %v", data.Users)
+	}
+}
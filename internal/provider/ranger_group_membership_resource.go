@@ -0,0 +1,321 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-scaffolding-framework/internal/ranger"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &rangerGroupMembershipResource{}
+	_ resource.ResourceWithImportState = &rangerGroupMembershipResource{}
+)
+
+// NewRangerGroupMembershipResource is a helper function to simplify the provider implementation.
+func NewRangerGroupMembershipResource() resource.Resource {
+	return &rangerGroupMembershipResource{}
+}
+
+// rangerGroupMembershipResource is the resource implementation.
+type rangerGroupMembershipResource struct {
+	client *RangerClient
+}
+
+// RangerGroupMembershipResourceModel maps the resource schema to Go objects.
+type RangerGroupMembershipResourceModel struct {
+	ID            types.String   `tfsdk:"id"`
+	Group         types.String   `tfsdk:"group"`
+	Users         []types.String `tfsdk:"users"`
+	Authoritative types.Bool     `tfsdk:"authoritative"`
+}
+
+// Metadata returns the resource type name.
+func (r *rangerGroupMembershipResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_group_membership"
+}
+
+// Schema defines the schema for the resource.
+func (r *rangerGroupMembershipResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages the users of an internal Apache Ranger group, either authoritatively or additively. Groups synchronized by usersync are refused",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The name of the group",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"group": schema.StringAttribute{
+				MarkdownDescription: "The name of the group",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"users": schema.SetAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "The names of the member users",
+				Required:            true,
+			},
+			"authoritative": schema.BoolAttribute{
+				MarkdownDescription: "Whether `users` are the only members of the group, removing any other member. When `false` (default), members added outside this resource are left alone",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *rangerGroupMembershipResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*RangerClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *RangerClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// Create adds the users to the Ranger group.
+func (r *rangerGroupMembershipResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan RangerGroupMembershipResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.applyMembership(ctx, plan, nil)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.ID = plan.Group
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "Created Ranger group membership", map[string]interface{}{
+		"group": plan.Group.ValueString(),
+	})
+}
+
+// Read reads the members of the Ranger group from the API.
+func (r *rangerGroupMembershipResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state RangerGroupMembershipResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	groupUsers, err := r.client.GetGroupUsers(ctx, state.ID.ValueString())
+	if ranger.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Ranger Group Membership",
+			fmt.Sprintf("Could not read members of group %q: %s", state.ID.ValueString(), err),
+		)
+		return
+	}
+
+	members := make([]string, 0, len(groupUsers.Users))
+	for _, user := range groupUsers.Users {
+		members = append(members, user.Name)
+	}
+
+	// On import every member is adopted, additively
+	if state.Group.IsNull() {
+		state.Group = types.StringValue(groupUsers.Group.Name)
+		state.Authoritative = types.BoolValue(false)
+		state.Users = readMembers(nil, members, true)
+	} else {
+		state.Users = readMembers(valueStrings(state.Users), members, state.Authoritative.ValueBool())
+	}
+
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update updates the users of the Ranger group.
+func (r *rangerGroupMembershipResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state RangerGroupMembershipResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.applyMembership(ctx, plan, valueStrings(state.Users))...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags := resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "Updated Ranger group membership", map[string]interface{}{
+		"group": plan.Group.ValueString(),
+	})
+}
+
+// Delete removes the managed users from the Ranger group.
+func (r *rangerGroupMembershipResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state RangerGroupMembershipResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Removing every managed user is applying an empty, additive membership
+	prior := valueStrings(state.Users)
+	state.Users = nil
+	state.Authoritative = types.BoolValue(false)
+
+	resp.Diagnostics.Append(r.applyMembership(ctx, state, prior)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "Deleted Ranger group membership", map[string]interface{}{
+		"group": state.Group.ValueString(),
+	})
+}
+
+// ImportState imports the members of a Ranger group by group name.
+func (r *rangerGroupMembershipResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// applyMembership adds and removes users of the group so that its members
+// match the model. Users of prior that are no longer in the model are
+// removed, as well as any other member when the membership is authoritative.
+func (r *rangerGroupMembershipResource) applyMembership(ctx context.Context, model RangerGroupMembershipResourceModel, prior []string) diag.Diagnostics {
+	var diags diag.Diagnostics
+	groupName := model.Group.ValueString()
+
+	groupUsers, err := r.client.GetGroupUsers(ctx, groupName)
+	if ranger.IsNotFound(err) && len(model.Users) == 0 {
+		return diags
+	}
+	if err != nil {
+		diags.AddError(
+			"Error Reading Ranger Group Membership",
+			fmt.Sprintf("Could not read members of group %q: %s", groupName, err),
+		)
+		return diags
+	}
+
+	diags.Append(checkPrincipalModifiable("Group", groupName, types.StringValue(principalSourceName(groupUsers.Group.GroupSource)))...)
+	if diags.HasError() {
+		return diags
+	}
+
+	members := make([]string, 0, len(groupUsers.Users))
+	for _, user := range groupUsers.Users {
+		members = append(members, user.Name)
+	}
+
+	add, remove := membershipChanges(members, valueStrings(model.Users), prior, model.Authoritative.ValueBool())
+
+	for _, userName := range add {
+		user, err := r.client.GetUserByName(ctx, userName)
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("users").AtSetValue(types.StringValue(userName)),
+				"Error Updating Ranger Group Membership",
+				fmt.Sprintf("Could not find user %q: %s", userName, err),
+			)
+			continue
+		}
+
+		if err := r.client.AddGroupUser(ctx, &groupUsers.Group, user.ID); err != nil {
+			diags.AddError(
+				"Error Updating Ranger Group Membership",
+				fmt.Sprintf("Could not add user %q to group %q: %s", userName, groupName, err),
+			)
+		}
+	}
+
+	for _, userName := range remove {
+		err := r.client.RemoveGroupUser(ctx, groupName, userName)
+		if err != nil && !ranger.IsNotFound(err) {
+			diags.AddError(
+				"Error Updating Ranger Group Membership",
+				fmt.Sprintf("Could not remove user %q from group %q: %s", userName, groupName, err),
+			)
+		}
+	}
+
+	return diags
+}
+
+// membershipChanges returns the users to add to and remove from a group
+// whose current members are members, for its members to become desired.
+// Users of prior, the previously managed users, that are no longer desired
+// are removed, as well as any other member when authoritative is set.
+func membershipChanges(members, desired, prior []string, authoritative bool) (add, remove []string) {
+	for _, user := range desired {
+		if !containsString(members, user) {
+			add = append(add, user)
+		}
+	}
+
+	for _, user := range members {
+		if containsString(desired, user) {
+			continue
+		}
+		if authoritative || containsString(prior, user) {
+			remove = append(remove, user)
+		}
+	}
+
+	return add, remove
+}
+
+// readMembers returns the users of the membership found in the group. An
+// authoritative membership tracks every member, so that members added
+// outside Terraform show up as drift.
+func readMembers(managed, members []string, authoritative bool) []types.String {
+	users := []types.String{}
+	for _, member := range members {
+		if authoritative || containsString(managed, member) {
+			users = append(users, types.StringValue(member))
+		}
+	}
+	return users
+}
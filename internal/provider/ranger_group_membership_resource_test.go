@@ -0,0 +1,65 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestMembershipChanges(t *testing.T) {
+	members := []string{"alice", "bob", "usersync_added"}
+
+	testCases := map[string]struct {
+		desired       []string
+		prior         []string
+		authoritative bool
+		add, remove   []string
+	}{
+		"additive keeps unmanaged members": {
+			desired: []string{"alice", "carol"},
+			prior:   []string{"alice", "bob"},
+			add:     []string{"carol"},
+			remove:  []string{"bob"},
+		},
+		"authoritative removes unmanaged members": {
+			desired:       []string{"alice", "carol"},
+			prior:         []string{"alice", "bob"},
+			authoritative: true,
+			add:           []string{"carol"},
+			remove:        []string{"bob", "usersync_added"},
+		},
+		"delete removes managed members only": {
+			prior:  []string{"alice"},
+			remove: []string{"alice"},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			add, remove := membershipChanges(members, tc.desired, tc.prior, tc.authoritative)
+			if !reflect.DeepEqual(add, tc.add) || !reflect.DeepEqual(remove, tc.remove) {
+				t.Errorf("got add %v, remove %v; want add %v, remove %v", add, remove, tc.add, tc.remove)
+			}
+		})
+	}
+}
+
+func TestReadMembers(t *testing.T) {
+	members := []string{"alice", "usersync_added"}
+
+	additive := readMembers([]string{"alice", "bob"}, members, false)
+	if len(additive) != 1 || additive[0].ValueString() != "alice" {
+		t.Errorf("unexpected additive members: %v", additive)
+	}
+
+	authoritative := readMembers([]string{"alice"}, members, true)
+	if len(authoritative) != 2 {
+		t.Errorf("expected unmanaged members to show up as drift, got %v", authoritative)
+	}
+
+	if empty := readMembers([]string{"bob"}, members, false); empty == nil || len(empty) != 0 {
+		t.Errorf("expected an empty, non-null set, got %v", empty)
+	}
+}
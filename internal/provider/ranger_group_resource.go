@@ -0,0 +1,347 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-scaffolding-framework/internal/ranger"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &rangerGroupResource{}
+	_ resource.ResourceWithImportState    = &rangerGroupResource{}
+	_ resource.ResourceWithValidateConfig = &rangerGroupResource{}
+	_ resource.ResourceWithModifyPlan     = &rangerGroupResource{}
+)
+
+// NewRangerGroupResource is a helper function to simplify the provider implementation.
+func NewRangerGroupResource() resource.Resource {
+	return &rangerGroupResource{}
+}
+
+// rangerGroupResource is the resource implementation.
+type rangerGroupResource struct {
+	client *RangerClient
+}
+
+// RangerGroupResourceModel maps the resource schema to Go objects.
+type RangerGroupResourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	GroupSource types.String `tfsdk:"group_source"`
+}
+
+// principalSources maps the source values of users and groups to Ranger's sources.
+var principalSources = map[string]int64{
+	"internal": ranger.SourceInternal,
+	"external": ranger.SourceExternal,
+}
+
+// principalSourceName returns the source value of a Ranger user or group source.
+func principalSourceName(source int64) string {
+	for name, value := range principalSources {
+		if value == source {
+			return name
+		}
+	}
+	return fmt.Sprintf("%d", source)
+}
+
+// Metadata returns the resource type name.
+func (r *rangerGroupResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_group"
+}
+
+// Schema defines the schema for the resource.
+func (r *rangerGroupResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Apache Ranger group resource. Groups synchronized by usersync can be imported but not modified",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The internal ID of the group in Apache Ranger",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the group. Policies refer to the group by this name",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "A human-readable description of the group",
+				Optional:            true,
+			},
+			"group_source": schema.StringAttribute{
+				MarkdownDescription: "Where the group comes from: `internal` (default) for groups created in Ranger, `external` for groups synchronized by usersync from LDAP/AD or Unix. External groups cannot be changed or deleted afterwards",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// ValidateConfig checks the source of the group.
+func (r *rangerGroupResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var groupSource types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("group_source"), &groupSource)...)
+	if groupSource.IsNull() || groupSource.IsUnknown() {
+		return
+	}
+	if _, ok := principalSources[groupSource.ValueString()]; !ok {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("group_source"),
+			"Invalid Group Source",
+			fmt.Sprintf("group_source must be %q or %q, got %q.", "internal", "external", groupSource.ValueString()),
+		)
+	}
+}
+
+// ModifyPlan refuses to change or delete groups synchronized by usersync.
+func (r *rangerGroupResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.State.Raw.IsNull() || req.Plan.Raw.Equal(req.State.Raw) {
+		return
+	}
+
+	var state RangerGroupResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(checkPrincipalModifiable("Group", state.Name.ValueString(), state.GroupSource)...)
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *rangerGroupResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*RangerClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *RangerClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// Create creates a new Ranger group.
+func (r *rangerGroupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan RangerGroupResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	group := convertModelToGroup(plan)
+
+	createdGroup, err := r.client.CreateGroup(ctx, &group)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating Ranger Group",
+			fmt.Sprintf("Could not create group %q: %s", group.Name, err),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(fmt.Sprintf("%d", createdGroup.ID))
+	plan.GroupSource = types.StringValue(principalSourceName(createdGroup.GroupSource))
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "Created Ranger group", map[string]interface{}{
+		"id":   createdGroup.ID,
+		"name": createdGroup.Name,
+	})
+}
+
+// Read reads the Ranger group from the API.
+func (r *rangerGroupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state RangerGroupResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := parseInt64(state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Ranger Group",
+			fmt.Sprintf("Could not parse group ID: %s", err),
+		)
+		return
+	}
+
+	group, err := r.client.GetGroup(ctx, id)
+	if ranger.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Ranger Group",
+			fmt.Sprintf("Could not read group ID %d: %s", id, err),
+		)
+		return
+	}
+
+	model := convertGroupToModel(*group)
+
+	diags = resp.State.Set(ctx, model)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update updates an existing Ranger group.
+func (r *rangerGroupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan RangerGroupResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := parseInt64(plan.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Ranger Group",
+			fmt.Sprintf("Could not parse group ID: %s", err),
+		)
+		return
+	}
+
+	group := convertModelToGroup(plan)
+	group.ID = id
+
+	updatedGroup, err := r.client.UpdateGroup(ctx, id, &group)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Ranger Group",
+			fmt.Sprintf("Could not update group ID %d: %s", id, err),
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "Updated Ranger group", map[string]interface{}{
+		"id":   updatedGroup.ID,
+		"name": updatedGroup.Name,
+	})
+}
+
+// Delete deletes a Ranger group.
+func (r *rangerGroupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state RangerGroupResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := parseInt64(state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting Ranger Group",
+			fmt.Sprintf("Could not parse group ID: %s", err),
+		)
+		return
+	}
+
+	err = r.client.DeleteGroup(ctx, id)
+	if err != nil && !ranger.IsNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Error Deleting Ranger Group",
+			fmt.Sprintf("Could not delete group ID %d: %s", id, err),
+		)
+		return
+	}
+
+	tflog.Info(ctx, "Deleted Ranger group", map[string]interface{}{
+		"id": id,
+	})
+}
+
+// ImportState imports a Ranger group by name.
+func (r *rangerGroupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	group, err := r.client.GetGroupByName(ctx, req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Importing Ranger Group",
+			fmt.Sprintf("Could not find group %q: %s", req.ID, err),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), fmt.Sprintf("%d", group.ID))...)
+}
+
+// convertModelToGroup converts a Terraform model to a Ranger group. Groups
+// are internal unless group_source says otherwise.
+func convertModelToGroup(model RangerGroupResourceModel) ranger.Group {
+	group := ranger.Group{
+		Name:        model.Name.ValueString(),
+		Description: model.Description.ValueString(),
+		GroupSource: ranger.SourceInternal,
+	}
+	if source, ok := principalSources[model.GroupSource.ValueString()]; ok {
+		group.GroupSource = source
+	}
+	return group
+}
+
+// convertGroupToModel converts a Ranger group to a Terraform model.
+func convertGroupToModel(group ranger.Group) RangerGroupResourceModel {
+	return RangerGroupResourceModel{
+		ID:          types.StringValue(fmt.Sprintf("%d", group.ID)),
+		Name:        types.StringValue(group.Name),
+		Description: stringValueOrNull(group.Description),
+		GroupSource: types.StringValue(principalSourceName(group.GroupSource)),
+	}
+}
+
+// checkPrincipalModifiable returns an error for users and groups synchronized
+// by usersync, whose changes would be overwritten on the next sync or fight
+// with it. kind is "User" or "Group".
+func checkPrincipalModifiable(kind, name string, source types.String) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if source.ValueString() == principalSourceName(ranger.SourceExternal) {
+		diags.AddError(
+			"External Ranger "+kind,
+			fmt.Sprintf("%s %q is synchronized by usersync and cannot be modified through Terraform. Change it in its source directory, or remove it from the Terraform state.", kind, name),
+		)
+	}
+	return diags
+}
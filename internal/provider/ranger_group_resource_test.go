@@ -0,0 +1,123 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-provider-scaffolding-framework/internal/ranger"
+)
+
+func TestGroupModelRoundTrip(t *testing.T) {
+	model := RangerGroupResourceModel{
+		ID:          types.StringUnknown(),
+		Name:        types.StringValue("analysts"),
+		Description: types.StringNull(),
+		GroupSource: types.StringUnknown(),
+	}
+
+	// An unknown source creates an internal group
+	group := convertModelToGroup(model)
+	if group.Name != "analysts" || group.Description != "" || group.GroupSource != ranger.SourceInternal {
+		t.Errorf("unexpected group: %+v", group)
+	}
+
+	model.GroupSource = types.StringValue("external")
+	if group := convertModelToGroup(model); group.GroupSource != ranger.SourceExternal {
+		t.Errorf("expected an external group, got source %d", group.GroupSource)
+	}
+
+	converted := convertGroupToModel(ranger.Group{ID: 3, Name: "analysts", Description: "Data analysts", GroupSource: ranger.SourceExternal})
+	if converted.ID.ValueString() != "3" || converted.Description.ValueString() != "Data analysts" || converted.GroupSource.ValueString() != "external" {
+		t.Errorf("unexpected model: %+v", converted)
+	}
+	if converted := convertGroupToModel(ranger.Group{ID: 4, Name: "etl"}); !converted.Description.IsNull() || converted.GroupSource.ValueString() != "internal" {
+		t.Errorf("unexpected model: %+v", converted)
+	}
+}
+
+func TestPrincipalSourceAndGroupStatusNames(t *testing.T) {
+	for name, source := range principalSources {
+		if got := principalSourceName(source); got != name {
+			t.Errorf("principalSourceName(%d) = %q, want %q", source, got, name)
+		}
+	}
+	for name, status := range groupStatuses {
+		if got := groupStatusName(status); got != name {
+			t.Errorf("groupStatusName(%d) = %q, want %q", status, got, name)
+		}
+	}
+
+	// Unknown values are shown as they are
+	if got := principalSourceName(7); got != "7" {
+		t.Errorf("unexpected name of an unknown source: %q", got)
+	}
+	if got := groupStatusName(7); got != "7" {
+		t.Errorf("unexpected name of an unknown status: %q", got)
+	}
+}
+
+func TestGroupValidateConfig(t *testing.T) {
+	r := NewRangerGroupResource().(*rangerGroupResource)
+	plan := testResourcePlan(t, r, RangerGroupResourceModel{
+		ID:          types.StringNull(),
+		Name:        types.StringValue("analysts"),
+		Description: types.StringNull(),
+		GroupSource: types.StringValue("ldap"),
+	})
+
+	resp := &resource.ValidateConfigResponse{}
+	r.ValidateConfig(context.Background(), resource.ValidateConfigRequest{
+		Config: tfsdk.Config{Schema: plan.Schema, Raw: plan.Raw},
+	}, resp)
+	if resp.Diagnostics.ErrorsCount() != 1 || resp.Diagnostics[0].Summary() != "Invalid Group Source" {
+		t.Errorf("expected an invalid source error, got: %v", resp.Diagnostics)
+	}
+}
+
+func TestGroupModifyPlanExternal(t *testing.T) {
+	ctx := context.Background()
+	r := NewRangerGroupResource().(*rangerGroupResource)
+	model := RangerGroupResourceModel{
+		ID:          types.StringValue("3"),
+		Name:        types.StringValue("analysts"),
+		Description: types.StringNull(),
+		GroupSource: types.StringValue("external"),
+	}
+
+	modifyPlan := func(plan tfsdk.Plan) *resource.ModifyPlanResponse {
+		prior := testResourcePlan(t, r, model)
+		req := resource.ModifyPlanRequest{
+			Config: tfsdk.Config{Schema: plan.Schema, Raw: plan.Raw},
+			Plan:   plan,
+			State:  tfsdk.State{Schema: prior.Schema, Raw: prior.Raw},
+		}
+		resp := &resource.ModifyPlanResponse{Plan: req.Plan}
+		r.ModifyPlan(ctx, req, resp)
+		return resp
+	}
+
+	if resp := modifyPlan(testResourcePlan(t, r, model)); resp.Diagnostics.HasError() {
+		t.Errorf("unexpected errors for an unchanged group: %v", resp.Diagnostics)
+	}
+
+	changed := model
+	changed.Description = types.StringValue("Data analysts")
+	resp := modifyPlan(testResourcePlan(t, r, changed))
+	if resp.Diagnostics.ErrorsCount() != 1 || resp.Diagnostics[0].Summary() != "External Ranger Group" {
+		t.Errorf("expected an external group error on update, got: %v", resp.Diagnostics)
+	}
+
+	destroy := testResourcePlan(t, r, model)
+	destroy.Raw = tftypes.NewValue(destroy.Raw.Type(), nil)
+	resp = modifyPlan(destroy)
+	if resp.Diagnostics.ErrorsCount() != 1 || resp.Diagnostics[0].Summary() != "External Ranger Group" {
+		t.Errorf("expected an external group error on delete, got: %v", resp.Diagnostics)
+	}
+}
@@ -0,0 +1,201 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &RangerPoliciesDataSource{}
+
+// NewRangerPoliciesDataSource creates a new data source listing Ranger policies.
+func NewRangerPoliciesDataSource() datasource.DataSource {
+	return &RangerPoliciesDataSource{}
+}
+
+// RangerPoliciesDataSource defines the data source implementation.
+type RangerPoliciesDataSource struct {
+	client *RangerClient
+}
+
+// RangerPoliciesDataSourceModel describes the data source data model.
+type RangerPoliciesDataSourceModel struct {
+	ServiceName         types.String                  `tfsdk:"service_name"`
+	ServiceType         types.String                  `tfsdk:"service_type"`
+	PolicyType          types.Int64                   `tfsdk:"policy_type"`
+	Resources           map[string]types.String       `tfsdk:"resources"`
+	User                types.String                  `tfsdk:"user"`
+	Group               types.String                  `tfsdk:"group"`
+	Role                types.String                  `tfsdk:"role"`
+	PolicyLabelsPartial types.String                  `tfsdk:"policy_labels_partial"`
+	ZoneName            types.String                  `tfsdk:"zone_name"`
+	IsEnabled           types.Bool                    `tfsdk:"is_enabled"`
+	Policies            []RangerPolicyDataSourceModel `tfsdk:"policies"`
+}
+
+// Metadata returns the data source type name.
+func (d *RangerPoliciesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_policies"
+}
+
+// Schema defines the schema for the data source.
+func (d *RangerPoliciesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "List the Apache Ranger policies matching a set of filters. Filters left unset match every policy",
+		Attributes: map[string]schema.Attribute{
+			"service_name": schema.StringAttribute{
+				MarkdownDescription: "Only list the policies of this service",
+				Optional:            true,
+			},
+			"service_type": schema.StringAttribute{
+				MarkdownDescription: "Only list the policies of services of this type (e.g., `hive`, `hdfs`)",
+				Optional:            true,
+			},
+			"policy_type": schema.Int64Attribute{
+				MarkdownDescription: "Only list policies of this type (0 for access policy, 1 for data-mask, 2 for row-filter)",
+				Optional:            true,
+			},
+			"resources": schema.MapAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "Only list policies matching these resource values, keyed by resource component name (e.g., `database`, `path`)",
+				Optional:            true,
+			},
+			"user": schema.StringAttribute{
+				MarkdownDescription: "Only list policies with a rule for this user",
+				Optional:            true,
+			},
+			"group": schema.StringAttribute{
+				MarkdownDescription: "Only list policies with a rule for this group",
+				Optional:            true,
+			},
+			"role": schema.StringAttribute{
+				MarkdownDescription: "Only list policies with a rule for this role",
+				Optional:            true,
+			},
+			"policy_labels_partial": schema.StringAttribute{
+				MarkdownDescription: "Only list policies with a label containing this text",
+				Optional:            true,
+			},
+			"zone_name": schema.StringAttribute{
+				MarkdownDescription: "Only list the policies of this security zone",
+				Optional:            true,
+			},
+			"is_enabled": schema.BoolAttribute{
+				MarkdownDescription: "Only list enabled (`true`) or disabled (`false`) policies",
+				Optional:            true,
+			},
+			"policies": schema.ListNestedAttribute{
+				MarkdownDescription: "The matching policies",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: policyDataSourceAttributes(),
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *RangerPoliciesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*RangerClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *RangerClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+// Read reads the data source.
+func (d *RangerPoliciesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data RangerPoliciesDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	filter := policiesFilter(data)
+	tflog.Debug(ctx, "Listing Ranger policies", map[string]interface{}{
+		"filter": filter.Encode(),
+	})
+
+	policies, err := d.client.ListPolicies(ctx, "", filter)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Listing Ranger Policies",
+			fmt.Sprintf("Could not list policies: %s", err),
+		)
+		return
+	}
+
+	resource := &rangerPolicyResource{client: d.client}
+	data.Policies = make([]RangerPolicyDataSourceModel, 0, len(policies))
+	for _, policy := range policies {
+		// A policy that cannot be converted is skipped rather than failing
+		// the whole listing
+		model, diags := resource.convertPolicyToModel(ctx, policy)
+		if diags.HasError() {
+			for _, d := range diags.Errors() {
+				resp.Diagnostics.AddWarning(
+					"Skipped Ranger Policy",
+					fmt.Sprintf("Policy %q (ID %d) of service %q is not listed. %s: %s", policy.Name, policy.ID, policy.Service, d.Summary(), d.Detail()),
+				)
+			}
+			continue
+		}
+		resp.Diagnostics.Append(diags...)
+		data.Policies = append(data.Policies, policyDataSourceModel(model))
+	}
+
+	// Set the state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// policiesFilter returns the Ranger search parameters for the configured filters.
+func policiesFilter(data RangerPoliciesDataSourceModel) url.Values {
+	filter := url.Values{}
+
+	params := map[string]types.String{
+		"serviceName":         data.ServiceName,
+		"serviceType":         data.ServiceType,
+		"user":                data.User,
+		"group":               data.Group,
+		"role":                data.Role,
+		"policyLabelsPartial": data.PolicyLabelsPartial,
+		"zoneName":            data.ZoneName,
+	}
+	for param, value := range params {
+		if !value.IsNull() {
+			filter.Set(param, value.ValueString())
+		}
+	}
+
+	if !data.PolicyType.IsNull() {
+		filter.Set("policyType", strconv.FormatInt(data.PolicyType.ValueInt64(), 10))
+	}
+	if !data.IsEnabled.IsNull() {
+		filter.Set("isEnabled", strconv.FormatBool(data.IsEnabled.ValueBool()))
+	}
+	for resType, value := range data.Resources {
+		filter.Set("resource:"+resType, value.ValueString())
+	}
+
+	return filter
+}
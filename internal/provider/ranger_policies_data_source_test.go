@@ -0,0 +1,58 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestPoliciesFilter(t *testing.T) {
+	filter := policiesFilter(RangerPoliciesDataSourceModel{
+		ServiceName:         types.StringNull(),
+		ServiceType:         types.StringValue("hive"),
+		PolicyType:          types.Int64Value(0),
+		Resources:           map[string]types.String{"database": types.StringValue("sales")},
+		User:                types.StringNull(),
+		Group:               types.StringValue("contractors"),
+		Role:                types.StringNull(),
+		PolicyLabelsPartial: types.StringNull(),
+		ZoneName:            types.StringNull(),
+		IsEnabled:           types.BoolValue(false),
+	})
+
+	want := "group=contractors&isEnabled=false&policyType=0&resource%3Adatabase=sales&serviceType=hive"
+	if got := filter.Encode(); got != want {
+		t.Errorf("unexpected filter:\n got: %s\nwant: %s", got, want)
+	}
+}
+
+func TestPoliciesDataSourceSkipsUnconvertible(t *testing.T) {
+	resp := testDataSourceRead(t, &RangerPoliciesDataSource{}, "", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("startIndex") != "0" {
+			_, _ = w.Write([]byte(`[]`))
+			return
+		}
+		_, _ = w.Write([]byte(`[
+			{"id":1,"name":"sales","service":"hive_prod","resources":{"database":{"values":["sales"]}},"policyItems":[{"users":["alice"],"accesses":[{"type":"select","isAllowed":true}]}]},
+			{"id":2,"name":"office","service":"hive_prod","resources":{"database":{"values":["office"]}},"policyItems":[{"users":["bob"],"accesses":[{"type":"select","isAllowed":true}],"conditions":[{"type":"ip-range","values":[10]}]}]}
+		]`))
+	})
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected errors: %v", resp.Diagnostics)
+	}
+	if resp.Diagnostics.WarningsCount() != 1 || resp.Diagnostics[0].Summary() != "Skipped Ranger Policy" {
+		t.Errorf("expected the office policy to be skipped with a warning, got: %v", resp.Diagnostics)
+	}
+
+	var policies []RangerPolicyDataSourceModel
+	resp.Diagnostics.Append(resp.State.GetAttribute(context.Background(), path.Root("policies"), &policies)...)
+	if len(policies) != 1 || policies[0].Name.ValueString() != "sales" {
+		t.Errorf("expected only the sales policy, got: %+v", policies)
+	}
+}
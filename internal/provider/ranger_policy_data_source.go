@@ -5,16 +5,14 @@ package provider
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
-	"net/http"
-	"net/url"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-scaffolding-framework/internal/ranger"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
@@ -32,16 +30,27 @@ type RangerPolicyDataSource struct {
 
 // RangerPolicyDataSourceModel describes the data source data model.
 type RangerPolicyDataSourceModel struct {
-	ID             types.String                 `tfsdk:"id"`
-	Name           types.String                 `tfsdk:"name"`
-	Service        types.String                 `tfsdk:"service"`
-	Description    types.String                 `tfsdk:"description"`
-	IsEnabled      types.Bool                   `tfsdk:"is_enabled"`
-	IsAuditEnabled types.Bool                   `tfsdk:"is_audit_enabled"`
-	Resources      []RangerPolicyResourcesModel `tfsdk:"resources"`
-	PolicyItems    []RangerPolicyItemModel      `tfsdk:"policy_item"`
-	DenyItems      []RangerPolicyItemModel      `tfsdk:"deny_item"`
-	PolicyType     types.Int64                  `tfsdk:"policy_type"`
+	ID              types.String                          `tfsdk:"id"`
+	Name            types.String                          `tfsdk:"name"`
+	Service         types.String                          `tfsdk:"service"`
+	Description     types.String                          `tfsdk:"description"`
+	IsEnabled       types.Bool                            `tfsdk:"is_enabled"`
+	IsAuditEnabled  types.Bool                            `tfsdk:"is_audit_enabled"`
+	Resources       map[string]RangerPolicyResourcesModel `tfsdk:"resources"`
+	PolicyItems     []RangerPolicyItemModel               `tfsdk:"policy_item"`
+	DenyItems       []RangerPolicyItemModel               `tfsdk:"deny_item"`
+	AllowExceptions []RangerPolicyItemModel               `tfsdk:"allow_exception"`
+	DenyExceptions  []RangerPolicyItemModel               `tfsdk:"deny_exception"`
+	DataMaskItems   []RangerPolicyDataMaskItemModel       `tfsdk:"data_mask_item"`
+	RowFilterItems  []RangerPolicyRowFilterItemModel      `tfsdk:"row_filter_item"`
+	PolicyType      types.Int64                           `tfsdk:"policy_type"`
+	PolicyPriority  types.String                          `tfsdk:"policy_priority"`
+	PolicyLabels    types.Set                             `tfsdk:"policy_labels"`
+	ZoneName        types.String                          `tfsdk:"zone_name"`
+	IsDenyAllElse   types.Bool                            `tfsdk:"is_deny_all_else"`
+	Options         types.Map                             `tfsdk:"options"`
+	ServiceType     types.String                          `tfsdk:"service_type"`
+	Version         types.Int64                           `tfsdk:"version"`
 }
 
 // Metadata returns the data source type name.
@@ -51,134 +60,272 @@ func (d *RangerPolicyDataSource) Metadata(ctx context.Context, req datasource.Me
 
 // Schema defines the schema for the data source.
 func (d *RangerPolicyDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	// Attributes identifying the policy can be set to look it up
+	attributes := policyDataSourceAttributes()
+	attributes["id"] = schema.StringAttribute{
+		MarkdownDescription: "The internal ID of the policy in Apache Ranger",
+		Optional:            true,
+		Computed:            true,
+	}
+	attributes["name"] = schema.StringAttribute{
+		MarkdownDescription: "The name of the Ranger policy",
+		Required:            true,
+	}
+	attributes["service"] = schema.StringAttribute{
+		MarkdownDescription: "The name of the Ranger service (repository) to which the policy applies",
+		Required:            true,
+	}
+	attributes["zone_name"] = schema.StringAttribute{
+		MarkdownDescription: "The security zone the policy belongs to. Must be set to look up a policy of a zone by service and name",
+		Optional:            true,
+		Computed:            true,
+	}
+
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Retrieve information about an existing Apache Ranger policy",
-		Attributes: map[string]schema.Attribute{
-			"id": schema.StringAttribute{
-				MarkdownDescription: "The internal ID of the policy in Apache Ranger",
-				Optional:            true,
-				Computed:            true,
-			},
-			"name": schema.StringAttribute{
-				MarkdownDescription: "The name of the Ranger policy",
-				Required:            true,
-			},
-			"service": schema.StringAttribute{
-				MarkdownDescription: "The name of the Ranger service (repository) to which the policy applies",
-				Required:            true,
+		Attributes:          attributes,
+	}
+}
+
+// policyDataSourceAttributes returns the computed schema attributes describing
+// a policy, shared by the policy and policies data sources.
+func policyDataSourceAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			MarkdownDescription: "The internal ID of the policy in Apache Ranger",
+			Computed:            true,
+		},
+		"name": schema.StringAttribute{
+			MarkdownDescription: "The name of the Ranger policy",
+			Computed:            true,
+		},
+		"service": schema.StringAttribute{
+			MarkdownDescription: "The name of the Ranger service (repository) to which the policy applies",
+			Computed:            true,
+		},
+		"zone_name": schema.StringAttribute{
+			MarkdownDescription: "The security zone the policy belongs to",
+			Computed:            true,
+		},
+		"description": schema.StringAttribute{
+			MarkdownDescription: "A human-readable description of the policy's purpose",
+			Computed:            true,
+		},
+		"is_enabled": schema.BoolAttribute{
+			MarkdownDescription: "Whether the policy is enabled",
+			Computed:            true,
+		},
+		"is_audit_enabled": schema.BoolAttribute{
+			MarkdownDescription: "Whether access audits are enabled for this policy",
+			Computed:            true,
+		},
+		"policy_type": schema.Int64Attribute{
+			MarkdownDescription: "The type of policy (0 for access policy, 1 for data-mask, 2 for row-filter)",
+			Computed:            true,
+		},
+		"policy_priority": schema.StringAttribute{
+			MarkdownDescription: "The priority of the policy, `normal` or `override`",
+			Computed:            true,
+		},
+		"policy_labels": schema.SetAttribute{
+			ElementType:         types.StringType,
+			MarkdownDescription: "Labels used to group and search policies",
+			Computed:            true,
+		},
+		"is_deny_all_else": schema.BoolAttribute{
+			MarkdownDescription: "Whether all access not allowed by this policy is denied on its resources",
+			Computed:            true,
+		},
+		"options": schema.MapAttribute{
+			ElementType:         types.StringType,
+			MarkdownDescription: "Additional policy options",
+			Computed:            true,
+		},
+		"service_type": schema.StringAttribute{
+			MarkdownDescription: "The service-def type of the policy's service",
+			Computed:            true,
+		},
+		"version": schema.Int64Attribute{
+			MarkdownDescription: "The version of the policy, incremented by Ranger on every change",
+			Computed:            true,
+		},
+		"resources": schema.MapNestedAttribute{
+			MarkdownDescription: "The data resources that the policy protects, keyed by resource component name",
+			Computed:            true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"values": schema.ListAttribute{
+						ElementType:         types.StringType,
+						MarkdownDescription: "One or more resource values or patterns for this component",
+						Computed:            true,
+					},
+					"is_exclude": schema.BoolAttribute{
+						MarkdownDescription: "If `true`, the values represent an exclusion (policy will apply to all *except* these values)",
+						Computed:            true,
+					},
+					"is_recursive": schema.BoolAttribute{
+						MarkdownDescription: "If `true`, the policy applies to resources under the given value hierarchically",
+						Computed:            true,
+					},
+				},
 			},
-			"description": schema.StringAttribute{
-				MarkdownDescription: "A human-readable description of the policy's purpose",
-				Computed:            true,
+		},
+		"policy_item": schema.SetNestedAttribute{
+			MarkdownDescription: "Allow rule entries in the policy",
+			Computed:            true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: policyItemDataSourceAttributes("allow rule", "The list of access actions allowed"),
 			},
-			"is_enabled": schema.BoolAttribute{
-				MarkdownDescription: "Whether the policy is enabled",
-				Computed:            true,
+		},
+		"deny_item": schema.SetNestedAttribute{
+			MarkdownDescription: "Deny rule entries in the policy",
+			Computed:            true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: policyItemDataSourceAttributes("deny rule", "The list of access actions denied"),
 			},
-			"is_audit_enabled": schema.BoolAttribute{
-				MarkdownDescription: "Whether access audits are enabled for this policy",
-				Computed:            true,
+		},
+		"allow_exception": schema.SetNestedAttribute{
+			MarkdownDescription: "Exceptions to the allow rules in the policy",
+			Computed:            true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: policyItemDataSourceAttributes("allow exception", "The list of access actions excluded from the allow rules"),
 			},
-			"policy_type": schema.Int64Attribute{
-				MarkdownDescription: "The type of policy (0 for access policy, 1 for data-mask, 2 for row-filter)",
-				Computed:            true,
+		},
+		"deny_exception": schema.SetNestedAttribute{
+			MarkdownDescription: "Exceptions to the deny rules in the policy",
+			Computed:            true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: policyItemDataSourceAttributes("deny exception", "The list of access actions excluded from the deny rules"),
 			},
-			"resources": schema.ListNestedAttribute{
-				MarkdownDescription: "The set of data resources that the policy protects",
-				Computed:            true,
-				NestedObject: schema.NestedAttributeObject{
-					Attributes: map[string]schema.Attribute{
-						"type": schema.StringAttribute{
-							MarkdownDescription: "The resource component name (e.g., database, table, column, etc.)",
-							Computed:            true,
-						},
-						"values": schema.ListAttribute{
-							ElementType:         types.StringType,
-							MarkdownDescription: "One or more resource values or patterns for this component",
-							Computed:            true,
-						},
-						"is_exclude": schema.BoolAttribute{
-							MarkdownDescription: "If `true`, the values represent an exclusion (policy will apply to all *except* these values)",
-							Computed:            true,
-						},
-						"is_recursive": schema.BoolAttribute{
-							MarkdownDescription: "If `true`, the policy applies to resources under the given value hierarchically",
-							Computed:            true,
+		},
+		"data_mask_item": schema.ListNestedAttribute{
+			MarkdownDescription: "Data masking rule entries in the policy",
+			Computed:            true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"users": schema.SetAttribute{
+						ElementType:         types.StringType,
+						MarkdownDescription: "Users to whom this masking rule applies",
+						Computed:            true,
+					},
+					"groups": schema.SetAttribute{
+						ElementType:         types.StringType,
+						MarkdownDescription: "User groups to whom this masking rule applies",
+						Computed:            true,
+					},
+					"roles": schema.SetAttribute{
+						ElementType:         types.StringType,
+						MarkdownDescription: "Ranger roles to which this masking rule applies",
+						Computed:            true,
+					},
+					"permissions": schema.SetAttribute{
+						ElementType:         types.StringType,
+						MarkdownDescription: "The list of access actions the masking rule applies to",
+						Computed:            true,
+					},
+					"data_mask_info": schema.SingleNestedAttribute{
+						MarkdownDescription: "How the masked column value is transformed",
+						Computed:            true,
+						Attributes: map[string]schema.Attribute{
+							"data_mask_type": schema.StringAttribute{
+								MarkdownDescription: "The masking type defined by the service-def",
+								Computed:            true,
+							},
+							"condition_expr": schema.StringAttribute{
+								MarkdownDescription: "The condition under which the mask is applied",
+								Computed:            true,
+							},
+							"value_expr": schema.StringAttribute{
+								MarkdownDescription: "The expression producing the masked value for the `CUSTOM` mask type",
+								Computed:            true,
+							},
 						},
 					},
 				},
 			},
-			"policy_item": schema.ListNestedAttribute{
-				MarkdownDescription: "Allow rule entries in the policy",
-				Computed:            true,
-				NestedObject: schema.NestedAttributeObject{
-					Attributes: map[string]schema.Attribute{
-						"users": schema.ListAttribute{
-							ElementType:         types.StringType,
-							MarkdownDescription: "Users to whom this allow rule applies",
-							Computed:            true,
-						},
-						"groups": schema.ListAttribute{
-							ElementType:         types.StringType,
-							MarkdownDescription: "User groups to whom this allow rule applies",
-							Computed:            true,
-						},
-						"roles": schema.ListAttribute{
-							ElementType:         types.StringType,
-							MarkdownDescription: "Ranger roles to which this allow rule applies",
-							Computed:            true,
-						},
-						"permissions": schema.ListAttribute{
-							ElementType:         types.StringType,
-							MarkdownDescription: "The list of access actions allowed",
-							Computed:            true,
-						},
-						"delegate_admin": schema.BoolAttribute{
-							MarkdownDescription: "Whether the users/groups in this rule are allowed to further delegate (grant) this permission to others",
-							Computed:            true,
-						},
-						"conditions": schema.MapAttribute{
-							ElementType:         types.ListType{ElemType: types.StringType},
-							MarkdownDescription: "Additional Ranger conditions for this rule",
-							Computed:            true,
+		},
+		"row_filter_item": schema.ListNestedAttribute{
+			MarkdownDescription: "Row-level filter rule entries in the policy",
+			Computed:            true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"users": schema.SetAttribute{
+						ElementType:         types.StringType,
+						MarkdownDescription: "Users to whom this row filter applies",
+						Computed:            true,
+					},
+					"groups": schema.SetAttribute{
+						ElementType:         types.StringType,
+						MarkdownDescription: "User groups to whom this row filter applies",
+						Computed:            true,
+					},
+					"roles": schema.SetAttribute{
+						ElementType:         types.StringType,
+						MarkdownDescription: "Ranger roles to which this row filter applies",
+						Computed:            true,
+					},
+					"permissions": schema.SetAttribute{
+						ElementType:         types.StringType,
+						MarkdownDescription: "The list of access actions the row filter applies to",
+						Computed:            true,
+					},
+					"row_filter_info": schema.SingleNestedAttribute{
+						MarkdownDescription: "The filter applied to the rows of the table",
+						Computed:            true,
+						Attributes: map[string]schema.Attribute{
+							"filter_expr": schema.StringAttribute{
+								MarkdownDescription: "The SQL predicate rows must satisfy to be visible",
+								Computed:            true,
+							},
 						},
 					},
 				},
 			},
-			"deny_item": schema.ListNestedAttribute{
-				MarkdownDescription: "Deny rule entries in the policy",
-				Computed:            true,
-				NestedObject: schema.NestedAttributeObject{
-					Attributes: map[string]schema.Attribute{
-						"users": schema.ListAttribute{
-							ElementType:         types.StringType,
-							MarkdownDescription: "Users to whom this deny rule applies",
-							Computed:            true,
-						},
-						"groups": schema.ListAttribute{
-							ElementType:         types.StringType,
-							MarkdownDescription: "User groups to whom this deny rule applies",
-							Computed:            true,
-						},
-						"roles": schema.ListAttribute{
-							ElementType:         types.StringType,
-							MarkdownDescription: "Ranger roles to which this deny rule applies",
-							Computed:            true,
-						},
-						"permissions": schema.ListAttribute{
-							ElementType:         types.StringType,
-							MarkdownDescription: "The list of access actions denied",
-							Computed:            true,
-						},
-						"delegate_admin": schema.BoolAttribute{
-							MarkdownDescription: "Whether the users/groups in this rule are allowed to further delegate (grant) this permission to others",
-							Computed:            true,
-						},
-						"conditions": schema.MapAttribute{
-							ElementType:         types.ListType{ElemType: types.StringType},
-							MarkdownDescription: "Additional Ranger conditions for this rule",
-							Computed:            true,
-						},
+		},
+	}
+}
+
+// policyItemDataSourceAttributes returns the computed schema attributes shared
+// by the allow, deny and exception rule lists of a policy.
+func policyItemDataSourceAttributes(rule, permissionsDescription string) map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"users": schema.SetAttribute{
+			ElementType:         types.StringType,
+			MarkdownDescription: fmt.Sprintf("Users to whom this %s applies", rule),
+			Computed:            true,
+		},
+		"groups": schema.SetAttribute{
+			ElementType:         types.StringType,
+			MarkdownDescription: fmt.Sprintf("User groups to whom this %s applies", rule),
+			Computed:            true,
+		},
+		"roles": schema.SetAttribute{
+			ElementType:         types.StringType,
+			MarkdownDescription: fmt.Sprintf("Ranger roles to which this %s applies", rule),
+			Computed:            true,
+		},
+		"permissions": schema.SetAttribute{
+			ElementType:         types.StringType,
+			MarkdownDescription: permissionsDescription,
+			Computed:            true,
+		},
+		"delegate_admin": schema.BoolAttribute{
+			MarkdownDescription: "Whether the users/groups in this rule are allowed to further delegate (grant) this permission to others",
+			Computed:            true,
+		},
+		"condition": schema.SetNestedAttribute{
+			MarkdownDescription: fmt.Sprintf("Conditions that requests must also match for this %s to apply", rule),
+			Computed:            true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"type": schema.StringAttribute{
+						MarkdownDescription: "The condition type (e.g., `ip-range`, `_expression`, `accessed-together`)",
+						Computed:            true,
+					},
+					"values": schema.ListAttribute{
+						ElementType:         types.StringType,
+						MarkdownDescription: "The values the condition is evaluated with",
+						Computed:            true,
 					},
 				},
 			},
@@ -219,14 +366,14 @@ func (d *RangerPolicyDataSource) Read(ctx context.Context, req datasource.ReadRe
 		"id":      data.ID.ValueString(),
 	})
 
-	var policy Policy
+	var policy *ranger.Policy
 	var diags diag.Diagnostics
 
 	// If an ID is provided, look up policy by ID, otherwise use service and name
 	if !data.ID.IsNull() {
 		policy, diags = d.getPolicyByID(ctx, data.ID.ValueString())
 	} else {
-		policy, diags = d.getPolicyByServiceAndName(ctx, data.Service.ValueString(), data.Name.ValueString())
+		policy, diags = d.getPolicyByServiceAndName(ctx, data.Service.ValueString(), data.Name.ValueString(), data.ZoneName.ValueString())
 	}
 
 	resp.Diagnostics.Append(diags...)
@@ -236,154 +383,98 @@ func (d *RangerPolicyDataSource) Read(ctx context.Context, req datasource.ReadRe
 
 	// Convert API response to data source model
 	resource := &rangerPolicyResource{client: d.client}
-	model, diags := resource.convertPolicyToModel(ctx, policy)
+	model, diags := resource.convertPolicyToModel(ctx, *policy)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
 	// Set the data source attributes from the policy model
-	data.ID = model.ID
-	data.Name = model.Name
-	data.Service = model.Service
-	data.Description = model.Description
-	data.IsEnabled = model.IsEnabled
-	data.IsAuditEnabled = model.IsAuditEnabled
-	data.PolicyType = model.PolicyType
-	data.Resources = model.Resources
-	data.PolicyItems = model.PolicyItems
-	data.DenyItems = model.DenyItems
+	data = policyDataSourceModel(model)
 
 	// Set the state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
-// getPolicyByID retrieves a Ranger policy by its ID.
-func (d *RangerPolicyDataSource) getPolicyByID(ctx context.Context, id string) (Policy, diag.Diagnostics) {
-	var diags diag.Diagnostics
-
-	// Prepare for API request
-	url := fmt.Sprintf("%s/service/public/v2/api/policy/%s", d.client.Endpoint, id)
-	request, err := http.NewRequest("GET", url, nil)
-	if err != nil {
-		diags.AddError(
-			"Error Reading Ranger Policy",
-			fmt.Sprintf("Could not create request: %s", err),
-		)
-		return Policy{}, diags
+// policyDataSourceModel returns the data source model of a converted policy.
+func policyDataSourceModel(model RangerPolicyResourceModel) RangerPolicyDataSourceModel {
+	return RangerPolicyDataSourceModel{
+		ID:              model.ID,
+		Name:            model.Name,
+		Service:         model.Service,
+		Description:     model.Description,
+		IsEnabled:       model.IsEnabled,
+		IsAuditEnabled:  model.IsAuditEnabled,
+		PolicyType:      model.PolicyType,
+		PolicyPriority:  model.PolicyPriority,
+		PolicyLabels:    model.PolicyLabels,
+		ZoneName:        model.ZoneName,
+		IsDenyAllElse:   model.IsDenyAllElse,
+		Options:         model.Options,
+		ServiceType:     model.ServiceType,
+		Version:         model.Version,
+		Resources:       model.Resources,
+		PolicyItems:     model.PolicyItems,
+		DenyItems:       model.DenyItems,
+		AllowExceptions: model.AllowExceptions,
+		DenyExceptions:  model.DenyExceptions,
+		DataMaskItems:   model.DataMaskItems,
+		RowFilterItems:  model.RowFilterItems,
 	}
+}
 
-	request.Header.Set("Authorization", d.client.AuthHeader)
-	request.Header.Set("Accept", "application/json")
+// getPolicyByID retrieves a Ranger policy by its ID.
+func (d *RangerPolicyDataSource) getPolicyByID(ctx context.Context, id string) (*ranger.Policy, diag.Diagnostics) {
+	var diags diag.Diagnostics
 
-	// Execute the API request
-	response, err := d.client.Client.Do(request)
+	policyID, err := parseInt64(id)
 	if err != nil {
 		diags.AddError(
 			"Error Reading Ranger Policy",
-			fmt.Sprintf("Could not execute API request: %s", err),
+			fmt.Sprintf("Could not parse policy ID: %s", err),
 		)
-		return Policy{}, diags
+		return nil, diags
 	}
-	defer response.Body.Close()
 
-	// Check if the policy exists
-	if response.StatusCode == http.StatusNotFound {
+	policy, err := d.client.GetPolicy(ctx, policyID)
+	if ranger.IsNotFound(err) {
 		diags.AddError(
 			"Ranger Policy Not Found",
 			fmt.Sprintf("No policy found with ID %s", id),
 		)
-		return Policy{}, diags
+		return nil, diags
 	}
-
-	// Check for other errors
-	if response.StatusCode != http.StatusOK {
-		diags.AddError(
-			"Error Reading Ranger Policy",
-			fmt.Sprintf("API returned unexpected status code: %d", response.StatusCode),
-		)
-		return Policy{}, diags
-	}
-
-	// Decode the response
-	var policy Policy
-	err = json.NewDecoder(response.Body).Decode(&policy)
 	if err != nil {
 		diags.AddError(
 			"Error Reading Ranger Policy",
-			fmt.Sprintf("Could not decode API response: %s", err),
+			fmt.Sprintf("Could not read policy ID %s: %s", id, err),
 		)
-		return Policy{}, diags
+		return nil, diags
 	}
 
 	return policy, diags
 }
 
-// getPolicyByServiceAndName retrieves a Ranger policy by service and name.
-func (d *RangerPolicyDataSource) getPolicyByServiceAndName(ctx context.Context, service, name string) (Policy, diag.Diagnostics) {
+// getPolicyByServiceAndName retrieves a Ranger policy by service and name,
+// within the given security zone when zone is not empty.
+func (d *RangerPolicyDataSource) getPolicyByServiceAndName(ctx context.Context, service, name, zone string) (*ranger.Policy, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
-	// Prepare for API request
-	apiURL := fmt.Sprintf("%s/service/public/v2/api/service/%s/policy", d.client.Endpoint, url.PathEscape(service))
-	request, err := http.NewRequest("GET", apiURL, nil)
-	if err != nil {
+	policy, err := d.client.GetPolicyByName(ctx, service, name, zone)
+	if ranger.IsNotFound(err) {
 		diags.AddError(
-			"Error Reading Ranger Policy",
-			fmt.Sprintf("Could not create request: %s", err),
-		)
-		return Policy{}, diags
-	}
-
-	// Add query parameters for name search
-	q := request.URL.Query()
-	q.Add("policyName", name)
-	request.URL.RawQuery = q.Encode()
-
-	request.Header.Set("Authorization", d.client.AuthHeader)
-	request.Header.Set("Accept", "application/json")
-
-	// Execute the API request
-	response, err := d.client.Client.Do(request)
-	if err != nil {
-		diags.AddError(
-			"Error Reading Ranger Policy",
-			fmt.Sprintf("Could not execute API request: %s", err),
-		)
-		return Policy{}, diags
-	}
-	defer response.Body.Close()
-
-	// Check for errors
-	if response.StatusCode != http.StatusOK {
-		diags.AddError(
-			"Error Reading Ranger Policy",
-			fmt.Sprintf("API returned unexpected status code: %d", response.StatusCode),
+			"Ranger Policy Not Found",
+			fmt.Sprintf("No policy found with service '%s' and name '%s'", service, name),
 		)
-		return Policy{}, diags
+		return nil, diags
 	}
-
-	// Decode the response (the API returns a list of policies)
-	var policies []Policy
-	err = json.NewDecoder(response.Body).Decode(&policies)
 	if err != nil {
 		diags.AddError(
 			"Error Reading Ranger Policy",
-			fmt.Sprintf("Could not decode API response: %s", err),
+			fmt.Sprintf("Could not read policy '%s' in service '%s': %s", name, service, err),
 		)
-		return Policy{}, diags
+		return nil, diags
 	}
 
-	// Find the policy with the matching name
-	for _, policy := range policies {
-		if policy.Name == name {
-			return policy, diags
-		}
-	}
-
-	// Policy not found
-	diags.AddError(
-		"Ranger Policy Not Found",
-		fmt.Sprintf("No policy found with service '%s' and name '%s'", service, name),
-	)
-	return Policy{}, diags
+	return policy, diags
 }
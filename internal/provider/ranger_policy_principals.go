@@ -0,0 +1,103 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-provider-scaffolding-framework/internal/ranger"
+)
+
+// policyPrincipalKind describes the users, groups or roles of policy items.
+type policyPrincipalKind struct {
+	attribute string
+	name      string
+	// builtin are the names Ranger resolves itself, which have no object.
+	builtin []string
+	lookup  func(ctx context.Context, client *RangerClient, name string) error
+}
+
+// policyPrincipalKinds are the kinds of principals policy items grant access to.
+var policyPrincipalKinds = []policyPrincipalKind{
+	{
+		attribute: "users",
+		name:      "User",
+		builtin:   []string{"{OWNER}", "{USER}"},
+		lookup: func(ctx context.Context, client *RangerClient, name string) error {
+			_, err := client.GetUserByName(ctx, name)
+			return err
+		},
+	},
+	{
+		attribute: "groups",
+		name:      "Group",
+		builtin:   []string{"public"},
+		lookup: func(ctx context.Context, client *RangerClient, name string) error {
+			_, err := client.GetGroupByName(ctx, name)
+			return err
+		},
+	},
+	{
+		attribute: "roles",
+		name:      "Role",
+		lookup: func(ctx context.Context, client *RangerClient, name string) error {
+			_, err := client.GetRoleByName(ctx, name)
+			return err
+		},
+	},
+}
+
+// validatePolicyPrincipals checks that the users, groups and roles of the
+// items of a planned policy exist, as Ranger accepts policies granting access
+// to unknown principals. Each principal is looked up once, however many items
+// refer to it. Unknown values are not checked.
+func validatePolicyPrincipals(ctx context.Context, plan tfsdk.Plan, client *RangerClient) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	items, itemDiags := plannedPolicyItems(ctx, plan)
+	diags.Append(itemDiags...)
+
+	lookups := map[string]error{}
+	for _, item := range items {
+		attributes := item.value.Attributes()
+
+		for _, kind := range policyPrincipalKinds {
+			for _, principal := range knownStrings(attributes[kind.attribute]) {
+				name := principal.ValueString()
+				if containsString(kind.builtin, name) {
+					continue
+				}
+
+				key := kind.attribute + "/" + name
+				err, ok := lookups[key]
+				if !ok {
+					err = kind.lookup(ctx, client, name)
+					lookups[key] = err
+				}
+
+				switch {
+				case err == nil:
+				case ranger.IsNotFound(err):
+					diags.AddAttributeError(
+						item.path.AtName(kind.attribute).AtSetValue(principal),
+						"Unknown Policy "+kind.name,
+						fmt.Sprintf("%s %q does not exist in Ranger.", kind.name, name),
+					)
+				default:
+					diags.AddAttributeError(
+						item.path.AtName(kind.attribute).AtSetValue(principal),
+						"Error Reading Ranger "+kind.name,
+						fmt.Sprintf("Could not check that %s %q exists: %s", strings.ToLower(kind.name), name, err),
+					)
+				}
+			}
+		}
+	}
+
+	return diags
+}
@@ -0,0 +1,95 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-scaffolding-framework/internal/ranger"
+)
+
+func TestValidatePolicyPrincipals(t *testing.T) {
+	requests := map[string]int{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests[r.URL.Path]++
+		switch r.URL.Path {
+		case "/service/xusers/users/userName/alice":
+			_, _ = w.Write([]byte(`{"id":1,"name":"alice"}`))
+		case "/service/xusers/groups/groupName/analysts":
+			_, _ = w.Write([]byte(`{"id":2,"name":"analysts"}`))
+		case "/service/public/v2/api/roles/name/stewards":
+			_, _ = w.Write([]byte(`{"id":3,"name":"stewards"}`))
+		default:
+			// Ranger reports unknown users as a bad request
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"statusCode":1,"msgDesc":"User not found","messageList":[{"name":"DATA_NOT_FOUND","message":"User not found"}]}`))
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	client := &RangerClient{Client: ranger.NewClient(ranger.Config{Endpoint: server.URL}), ValidatePrincipals: true}
+
+	model := RangerPolicyResourceModel{
+		ID:             types.StringUnknown(),
+		Name:           types.StringValue("sales"),
+		Service:        types.StringValue("hive_prod"),
+		Description:    types.StringNull(),
+		IsEnabled:      types.BoolValue(true),
+		IsAuditEnabled: types.BoolValue(true),
+		Resources: map[string]RangerPolicyResourcesModel{
+			"database": {Values: []types.String{types.StringValue("sales")}, IsExclude: types.BoolValue(false), IsRecursive: types.BoolValue(false)},
+		},
+		PolicyItems: []RangerPolicyItemModel{
+			{
+				Users:         []types.String{types.StringValue("alice"), types.StringValue("{OWNER}")},
+				Groups:        []types.String{types.StringValue("analysts"), types.StringValue("public")},
+				Roles:         []types.String{types.StringValue("stewards")},
+				Permissions:   []types.String{types.StringValue("select")},
+				DelegateAdmin: types.BoolValue(false),
+			},
+		},
+		DenyItems: []RangerPolicyItemModel{
+			{
+				Users:         []types.String{types.StringValue("alcie")},
+				Groups:        []types.String{types.StringValue("analysts")},
+				Permissions:   []types.String{types.StringValue("update")},
+				DelegateAdmin: types.BoolValue(false),
+			},
+		},
+		PolicyType:     types.Int64Value(ranger.PolicyTypeAccess),
+		PolicyPriority: types.StringUnknown(),
+		PolicyLabels:   types.SetUnknown(types.StringType),
+		ZoneName:       types.StringUnknown(),
+		IsDenyAllElse:  types.BoolUnknown(),
+		Options:        types.MapUnknown(types.StringType),
+		ServiceType:    types.StringUnknown(),
+		Version:        types.Int64Unknown(),
+	}
+
+	diags := validatePolicyPrincipals(context.Background(), testPolicyPlan(t, model), client)
+	if diags.ErrorsCount() != 1 || diags[0].Summary() != "Unknown Policy User" {
+		t.Fatalf("expected only the misspelled user to be reported, got: %v", diags)
+	}
+
+	withPath, ok := diags[0].(interface{ Path() path.Path })
+	if !ok {
+		t.Fatalf("expected an attribute error, got: %v", diags[0])
+	}
+	steps := withPath.Path().Steps()
+	if !steps[0].Equal(path.PathStepAttributeName("deny_item")) || !steps[len(steps)-1].Equal(path.PathStepElementKeyValue{Value: types.StringValue("alcie")}) {
+		t.Errorf("unexpected error path: %s", withPath.Path())
+	}
+
+	if requests["/service/xusers/groups/groupName/analysts"] != 1 {
+		t.Errorf("expected the group to be looked up once, got %d", requests["/service/xusers/groups/groupName/analysts"])
+	}
+	if requests["/service/xusers/groups/groupName/public"] != 0 || requests["/service/xusers/users/userName/{OWNER}"] != 0 {
+		t.Errorf("built-in principals were looked up: %v", requests)
+	}
+}
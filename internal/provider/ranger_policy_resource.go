@@ -7,25 +7,34 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"net/http"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-scaffolding-framework/internal/ranger"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource                = &rangerPolicyResource{}
-	_ resource.ResourceWithImportState = &rangerPolicyResource{}
+	_ resource.Resource                   = &rangerPolicyResource{}
+	_ resource.ResourceWithImportState    = &rangerPolicyResource{}
+	_ resource.ResourceWithValidateConfig = &rangerPolicyResource{}
+	_ resource.ResourceWithUpgradeState   = &rangerPolicyResource{}
+	_ resource.ResourceWithModifyPlan     = &rangerPolicyResource{}
 )
 
 // NewRangerPolicyResource is a helper function to simplify the provider implementation.
@@ -40,71 +49,82 @@ type rangerPolicyResource struct {
 
 // RangerPolicyResourceModel maps the resource schema to Go objects.
 type RangerPolicyResourceModel struct {
-	ID             types.String                 `tfsdk:"id"`
-	Name           types.String                 `tfsdk:"name"`
-	Service        types.String                 `tfsdk:"service"`
-	Description    types.String                 `tfsdk:"description"`
-	IsEnabled      types.Bool                   `tfsdk:"is_enabled"`
-	IsAuditEnabled types.Bool                   `tfsdk:"is_audit_enabled"`
-	Resources      []RangerPolicyResourcesModel `tfsdk:"resources"`
-	PolicyItems    []RangerPolicyItemModel      `tfsdk:"policy_item"`
-	DenyItems      []RangerPolicyItemModel      `tfsdk:"deny_item"`
-	PolicyType     types.Int64                  `tfsdk:"policy_type"`
-}
-
-// RangerPolicyResourcesModel represents a resource in a Ranger policy.
+	ID                types.String                          `tfsdk:"id"`
+	Name              types.String                          `tfsdk:"name"`
+	Service           types.String                          `tfsdk:"service"`
+	Description       types.String                          `tfsdk:"description"`
+	IsEnabled         types.Bool                            `tfsdk:"is_enabled"`
+	IsAuditEnabled    types.Bool                            `tfsdk:"is_audit_enabled"`
+	Resources         map[string]RangerPolicyResourcesModel `tfsdk:"resources"`
+	PolicyItems       []RangerPolicyItemModel               `tfsdk:"policy_item"`
+	DenyItems         []RangerPolicyItemModel               `tfsdk:"deny_item"`
+	AllowExceptions   []RangerPolicyItemModel               `tfsdk:"allow_exception"`
+	DenyExceptions    []RangerPolicyItemModel               `tfsdk:"deny_exception"`
+	DataMaskItems     []RangerPolicyDataMaskItemModel       `tfsdk:"data_mask_item"`
+	RowFilterItems    []RangerPolicyRowFilterItemModel      `tfsdk:"row_filter_item"`
+	ValiditySchedules []RangerPolicyValidityScheduleModel   `tfsdk:"validity_schedule"`
+	PolicyType        types.Int64                           `tfsdk:"policy_type"`
+	PolicyPriority    types.String                          `tfsdk:"policy_priority"`
+	PolicyLabels      types.Set                             `tfsdk:"policy_labels"`
+	ZoneName          types.String                          `tfsdk:"zone_name"`
+	IsDenyAllElse     types.Bool                            `tfsdk:"is_deny_all_else"`
+	Options           types.Map                             `tfsdk:"options"`
+	ServiceType       types.String                          `tfsdk:"service_type"`
+	Version           types.Int64                           `tfsdk:"version"`
+}
+
+// RangerPolicyResourcesModel represents a resource component in a Ranger
+// policy. Components are keyed by their type (database, table, path, ...).
 type RangerPolicyResourcesModel struct {
-	Type        types.String   `tfsdk:"type"`
 	Values      []types.String `tfsdk:"values"`
 	IsExclude   types.Bool     `tfsdk:"is_exclude"`
 	IsRecursive types.Bool     `tfsdk:"is_recursive"`
 }
 
-// RangerPolicyItemModel represents the policy items in a Ranger policy (allow/deny rules).
+// RangerPolicyItemModel represents the policy items in a Ranger policy (allow/deny rules and their exceptions).
 type RangerPolicyItemModel struct {
-	Users         []types.String            `tfsdk:"users"`
-	Groups        []types.String            `tfsdk:"groups"`
-	Roles         []types.String            `tfsdk:"roles"`
-	Permissions   []types.String            `tfsdk:"permissions"`
-	DelegateAdmin types.Bool                `tfsdk:"delegate_admin"`
-	Conditions    map[string][]types.String `tfsdk:"conditions"`
-}
-
-// Policy represents the Apache Ranger policy JSON structure
-type Policy struct {
-	ID              int64                      `json:"id,omitempty"`
-	Name            string                     `json:"name"`
-	Service         string                     `json:"service"`
-	Description     string                     `json:"description,omitempty"`
-	IsEnabled       bool                       `json:"isEnabled"`
-	IsAuditEnabled  bool                       `json:"isAuditEnabled"`
-	Resources       map[string]PolicyResources `json:"resources"`
-	PolicyItems     []PolicyItem               `json:"policyItems,omitempty"`
-	DenyPolicyItems []PolicyItem               `json:"denyPolicyItems,omitempty"`
-	PolicyType      int64                      `json:"policyType"`
-}
-
-// PolicyResources represents a resource in the Ranger policy JSON
-type PolicyResources struct {
-	Values      []string `json:"values"`
-	IsExclude   bool     `json:"isExcludeSupported,omitempty"`
-	IsRecursive bool     `json:"isRecursive,omitempty"`
-}
-
-// PolicyItem represents the policy items in the Ranger policy JSON
-type PolicyItem struct {
-	Users         []string                 `json:"users,omitempty"`
-	Groups        []string                 `json:"groups,omitempty"`
-	Roles         []string                 `json:"roles,omitempty"`
-	Accesses      []Access                 `json:"accesses"`
-	DelegateAdmin bool                     `json:"delegateAdmin"`
-	Conditions    []map[string]interface{} `json:"conditions,omitempty"`
-}
-
-// Access represents a permission in the Ranger policy JSON
-type Access struct {
-	Type      string `json:"type"`
-	IsAllowed bool   `json:"isAllowed"`
+	Users         []types.String               `tfsdk:"users"`
+	Groups        []types.String               `tfsdk:"groups"`
+	Roles         []types.String               `tfsdk:"roles"`
+	Permissions   []types.String               `tfsdk:"permissions"`
+	DelegateAdmin types.Bool                   `tfsdk:"delegate_admin"`
+	Conditions    []RangerPolicyConditionModel `tfsdk:"condition"`
+}
+
+// RangerPolicyConditionModel represents a condition restricting a policy item.
+type RangerPolicyConditionModel struct {
+	Type   types.String   `tfsdk:"type"`
+	Values []types.String `tfsdk:"values"`
+}
+
+// RangerPolicyDataMaskItemModel represents a data masking rule in a Ranger policy.
+type RangerPolicyDataMaskItemModel struct {
+	Users        []types.String          `tfsdk:"users"`
+	Groups       []types.String          `tfsdk:"groups"`
+	Roles        []types.String          `tfsdk:"roles"`
+	Permissions  []types.String          `tfsdk:"permissions"`
+	DataMaskInfo RangerDataMaskInfoModel `tfsdk:"data_mask_info"`
+}
+
+// RangerDataMaskInfoModel describes how a data masking rule transforms the column value.
+type RangerDataMaskInfoModel struct {
+	DataMaskType  types.String `tfsdk:"data_mask_type"`
+	ConditionExpr types.String `tfsdk:"condition_expr"`
+	ValueExpr     types.String `tfsdk:"value_expr"`
+}
+
+// RangerPolicyRowFilterItemModel represents a row-level filter rule in a Ranger policy.
+type RangerPolicyRowFilterItemModel struct {
+	Users         []types.String           `tfsdk:"users"`
+	Groups        []types.String           `tfsdk:"groups"`
+	Roles         []types.String           `tfsdk:"roles"`
+	Permissions   []types.String           `tfsdk:"permissions"`
+	RowFilterInfo RangerRowFilterInfoModel `tfsdk:"row_filter_info"`
+}
+
+// RangerRowFilterInfoModel holds the filter expression applied to the rows of a table.
+type RangerRowFilterInfoModel struct {
+	FilterExpr types.String `tfsdk:"filter_expr"`
 }
 
 // Metadata returns the resource type name.
@@ -116,6 +136,7 @@ func (r *rangerPolicyResource) Metadata(_ context.Context, req resource.Metadata
 func (r *rangerPolicyResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Apache Ranger Policy resource",
+		Version:             2,
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				MarkdownDescription: "The internal ID of the policy in Apache Ranger",
@@ -157,15 +178,64 @@ func (r *rangerPolicyResource) Schema(_ context.Context, _ resource.SchemaReques
 				Computed:            true,
 				Default:             int64default.StaticInt64(0),
 			},
-			"resources": schema.ListNestedAttribute{
-				MarkdownDescription: "The set of data resources that the policy protects",
+			"policy_priority": schema.StringAttribute{
+				MarkdownDescription: "The priority of the policy, `normal` or `override`. Override policies take precedence over normal ones. When not set, the priority stored in Ranger is left unchanged, so set it to `normal` to reset an override",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"policy_labels": schema.SetAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "Labels used to group and search policies. When not set, the labels stored in Ranger are left unchanged, so set it to `[]` to remove them",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"zone_name": schema.StringAttribute{
+				MarkdownDescription: "The name of the security zone (`ranger_security_zone`) the policy belongs to. When not set, the zone stored in Ranger is left unchanged, so set it to `\"\"` to move the policy out of its zone",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"is_deny_all_else": schema.BoolAttribute{
+				MarkdownDescription: "Whether all access not allowed by this policy is denied on its resources. When not set, the value stored in Ranger is left unchanged, so set it to `false` to turn it off",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"options": schema.MapAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "Additional policy options. When not set, the options stored in Ranger are left unchanged, so set it to `{}` to remove them",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"service_type": schema.StringAttribute{
+				MarkdownDescription: "The service-def type of the policy's service (e.g., `hive`), as reported by Ranger",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"version": schema.Int64Attribute{
+				MarkdownDescription: "The version of the policy, incremented by Ranger on every change. Updates fail when the policy was changed outside Terraform since the last refresh",
+				Computed:            true,
+			},
+			"resources": schema.MapNestedAttribute{
+				MarkdownDescription: "The data resources that the policy protects, keyed by resource component name (e.g., `database`, `table`, `column`, `path`). Tag-based policies of a `tag` service protect the `tag` resource, whose values are the names of tag definitions such as `ranger_tag_definition`",
 				Required:            true,
 				NestedObject: schema.NestedAttributeObject{
 					Attributes: map[string]schema.Attribute{
-						"type": schema.StringAttribute{
-							MarkdownDescription: "The resource component name (e.g., database, table, column, etc.)",
-							Required:            true,
-						},
 						"values": schema.ListAttribute{
 							ElementType:         types.StringType,
 							MarkdownDescription: "One or more resource values or patterns for this component",
@@ -186,80 +256,115 @@ func (r *rangerPolicyResource) Schema(_ context.Context, _ resource.SchemaReques
 					},
 				},
 			},
-			"policy_item": schema.ListNestedAttribute{
+			"policy_item": schema.SetNestedAttribute{
 				MarkdownDescription: "Defines an *allow* rule entry in the policy",
-				Required:            true,
+				Optional:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: policyItemAttributes("allow rule", "The list of access actions allowed"),
+				},
+			},
+			"deny_item": schema.SetNestedAttribute{
+				MarkdownDescription: "Defines a *deny* rule entry in the policy",
+				Optional:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: policyItemAttributes("deny rule", "The list of access actions denied"),
+				},
+			},
+			"allow_exception": schema.SetNestedAttribute{
+				MarkdownDescription: "Defines an exception to the *allow* rules: matching principals are not granted the listed access by this policy",
+				Optional:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: policyItemAttributes("allow exception", "The list of access actions excluded from the allow rules"),
+				},
+			},
+			"deny_exception": schema.SetNestedAttribute{
+				MarkdownDescription: "Defines an exception to the *deny* rules: matching principals are not denied the listed access by this policy",
+				Optional:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: policyItemAttributes("deny exception", "The list of access actions excluded from the deny rules"),
+				},
+			},
+			"data_mask_item": schema.ListNestedAttribute{
+				MarkdownDescription: "Defines a data masking rule. Only used when `policy_type` is `1`",
+				Optional:            true,
 				NestedObject: schema.NestedAttributeObject{
 					Attributes: map[string]schema.Attribute{
-						"users": schema.ListAttribute{
+						"users": schema.SetAttribute{
 							ElementType:         types.StringType,
-							MarkdownDescription: "Users to whom this allow rule applies",
+							MarkdownDescription: "Users to whom this masking rule applies",
 							Optional:            true,
 						},
-						"groups": schema.ListAttribute{
+						"groups": schema.SetAttribute{
 							ElementType:         types.StringType,
-							MarkdownDescription: "User groups to whom this allow rule applies",
+							MarkdownDescription: "User groups to whom this masking rule applies",
 							Optional:            true,
 						},
-						"roles": schema.ListAttribute{
+						"roles": schema.SetAttribute{
 							ElementType:         types.StringType,
-							MarkdownDescription: "Ranger roles to which this allow rule applies",
+							MarkdownDescription: "Ranger roles to which this masking rule applies",
 							Optional:            true,
 						},
-						"permissions": schema.ListAttribute{
+						"permissions": schema.SetAttribute{
 							ElementType:         types.StringType,
-							MarkdownDescription: "The list of access actions allowed",
+							MarkdownDescription: "The list of access actions the masking rule applies to (e.g., `select`)",
 							Required:            true,
 						},
-						"delegate_admin": schema.BoolAttribute{
-							MarkdownDescription: "Whether the users/groups in this rule are allowed to further delegate (grant) this permission to others",
-							Optional:            true,
-							Computed:            true,
-							Default:             booldefault.StaticBool(false),
-						},
-						"conditions": schema.MapAttribute{
-							ElementType:         types.ListType{ElemType: types.StringType},
-							MarkdownDescription: "Additional Ranger conditions for this rule (advanced use)",
-							Optional:            true,
+						"data_mask_info": schema.SingleNestedAttribute{
+							MarkdownDescription: "How the masked column value is transformed",
+							Required:            true,
+							Attributes: map[string]schema.Attribute{
+								"data_mask_type": schema.StringAttribute{
+									MarkdownDescription: "The masking type defined by the service-def (e.g., `MASK`, `MASK_SHOW_LAST_4`, `MASK_HASH`, `MASK_NULL`, `CUSTOM`)",
+									Required:            true,
+								},
+								"condition_expr": schema.StringAttribute{
+									MarkdownDescription: "An optional condition under which the mask is applied",
+									Optional:            true,
+								},
+								"value_expr": schema.StringAttribute{
+									MarkdownDescription: "The expression producing the masked value, required for the `CUSTOM` mask type (e.g., `concat('***', substr({col}, -4))`)",
+									Optional:            true,
+								},
+							},
 						},
 					},
 				},
 			},
-			"deny_item": schema.ListNestedAttribute{
-				MarkdownDescription: "Defines a *deny* rule entry in the policy",
+			"validity_schedule": validityScheduleAttribute(),
+			"row_filter_item": schema.ListNestedAttribute{
+				MarkdownDescription: "Defines a row-level filter rule. Only used when `policy_type` is `2`",
 				Optional:            true,
 				NestedObject: schema.NestedAttributeObject{
 					Attributes: map[string]schema.Attribute{
-						"users": schema.ListAttribute{
+						"users": schema.SetAttribute{
 							ElementType:         types.StringType,
-							MarkdownDescription: "Users to whom this deny rule applies",
+							MarkdownDescription: "Users to whom this row filter applies",
 							Optional:            true,
 						},
-						"groups": schema.ListAttribute{
+						"groups": schema.SetAttribute{
 							ElementType:         types.StringType,
-							MarkdownDescription: "User groups to whom this deny rule applies",
+							MarkdownDescription: "User groups to whom this row filter applies",
 							Optional:            true,
 						},
-						"roles": schema.ListAttribute{
+						"roles": schema.SetAttribute{
 							ElementType:         types.StringType,
-							MarkdownDescription: "Ranger roles to which this deny rule applies",
+							MarkdownDescription: "Ranger roles to which this row filter applies",
 							Optional:            true,
 						},
-						"permissions": schema.ListAttribute{
+						"permissions": schema.SetAttribute{
 							ElementType:         types.StringType,
-							MarkdownDescription: "The list of access actions denied",
+							MarkdownDescription: "The list of access actions the row filter applies to (e.g., `select`)",
 							Required:            true,
 						},
-						"delegate_admin": schema.BoolAttribute{
-							MarkdownDescription: "Whether the users/groups in this rule are allowed to further delegate (grant) this permission to others",
-							Optional:            true,
-							Computed:            true,
-							Default:             booldefault.StaticBool(false),
-						},
-						"conditions": schema.MapAttribute{
-							ElementType:         types.ListType{ElemType: types.StringType},
-							MarkdownDescription: "Additional Ranger conditions for this rule (advanced use)",
-							Optional:            true,
+						"row_filter_info": schema.SingleNestedAttribute{
+							MarkdownDescription: "The filter applied to the rows of the table",
+							Required:            true,
+							Attributes: map[string]schema.Attribute{
+								"filter_expr": schema.StringAttribute{
+									MarkdownDescription: "A SQL predicate rows must satisfy to be visible (e.g., `region = 'EMEA'`)",
+									Required:            true,
+								},
+							},
 						},
 					},
 				},
@@ -268,6 +373,56 @@ func (r *rangerPolicyResource) Schema(_ context.Context, _ resource.SchemaReques
 	}
 }
 
+// policyItemAttributes returns the schema attributes shared by the allow, deny
+// and exception rule lists of a policy.
+func policyItemAttributes(rule, permissionsDescription string) map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"users": schema.SetAttribute{
+			ElementType:         types.StringType,
+			MarkdownDescription: fmt.Sprintf("Users to whom this %s applies", rule),
+			Optional:            true,
+		},
+		"groups": schema.SetAttribute{
+			ElementType:         types.StringType,
+			MarkdownDescription: fmt.Sprintf("User groups to whom this %s applies", rule),
+			Optional:            true,
+		},
+		"roles": schema.SetAttribute{
+			ElementType:         types.StringType,
+			MarkdownDescription: fmt.Sprintf("Ranger roles to which this %s applies", rule),
+			Optional:            true,
+		},
+		"permissions": schema.SetAttribute{
+			ElementType:         types.StringType,
+			MarkdownDescription: permissionsDescription,
+			Required:            true,
+		},
+		"delegate_admin": schema.BoolAttribute{
+			MarkdownDescription: "Whether the users/groups in this rule are allowed to further delegate (grant) this permission to others",
+			Optional:            true,
+			Computed:            true,
+			Default:             booldefault.StaticBool(false),
+		},
+		"condition": schema.SetNestedAttribute{
+			MarkdownDescription: fmt.Sprintf("Conditions that requests must also match for this %s to apply. The types available are the `policyConditions` of the service definition", rule),
+			Optional:            true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"type": schema.StringAttribute{
+						MarkdownDescription: "The condition type (e.g., `ip-range`, `_expression`, `accessed-together`)",
+						Required:            true,
+					},
+					"values": schema.ListAttribute{
+						ElementType:         types.StringType,
+						MarkdownDescription: "The values the condition is evaluated with",
+						Required:            true,
+					},
+				},
+			},
+		},
+	}
+}
+
 // Configure adds the provider configured client to the resource.
 func (r *rangerPolicyResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
@@ -286,79 +441,146 @@ func (r *rangerPolicyResource) Configure(_ context.Context, req resource.Configu
 	r.client = client
 }
 
-// Create creates a new Ranger policy.
-func (r *rangerPolicyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var plan RangerPolicyResourceModel
-	diags := req.Plan.Get(ctx, &plan)
-	resp.Diagnostics.Append(diags...)
+// ValidateConfig checks the policy priority, that data masking and row filter
+// rules are only used with the matching policy type, and that validity
+// schedules are well formed.
+func (r *rangerPolicyResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var policyType types.Int64
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("policy_type"), &policyType)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	// Convert the plan to a Ranger policy
-	policy, diags := r.convertModelToPolicy(ctx, plan)
-	resp.Diagnostics.Append(diags...)
-	if resp.Diagnostics.HasError() {
+	var policyPriority types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("policy_priority"), &policyPriority)...)
+	if !policyPriority.IsNull() && !policyPriority.IsUnknown() {
+		if _, ok := policyPriorities[policyPriority.ValueString()]; !ok {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("policy_priority"),
+				"Invalid Policy Priority",
+				fmt.Sprintf("policy_priority must be %q or %q, got %q.", "normal", "override", policyPriority.ValueString()),
+			)
+		}
+	}
+
+	// policy_type defaults to an access policy when not configured
+	currentType := ranger.PolicyTypeAccess
+	if !policyType.IsNull() {
+		currentType = policyType.ValueInt64()
+	}
+
+	itemTypes := []struct {
+		attribute  string
+		policyType int64
+	}{
+		{"data_mask_item", ranger.PolicyTypeDataMask},
+		{"row_filter_item", ranger.PolicyTypeRowFilter},
+	}
+
+	for _, itemType := range itemTypes {
+		var items types.List
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(itemType.attribute), &items)...)
+		// Items can only be matched against a known policy type
+		if policyType.IsUnknown() || items.IsNull() || items.IsUnknown() || len(items.Elements()) == 0 {
+			continue
+		}
+
+		if currentType != itemType.policyType {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(itemType.attribute),
+				"Invalid Policy Item For Policy Type",
+				fmt.Sprintf("%s can only be used when policy_type is %d, got policy_type %d.", itemType.attribute, itemType.policyType, currentType),
+			)
+		}
+	}
+
+	// Recurrences are not validated, so they are decoded as a plain list to
+	// tolerate unknown values in them
+	var schedules []struct {
+		StartTime   types.String `tfsdk:"start_time"`
+		EndTime     types.String `tfsdk:"end_time"`
+		TimeZone    types.String `tfsdk:"time_zone"`
+		Recurrences types.List   `tfsdk:"recurrence"`
+	}
+	var scheduleList types.List
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("validity_schedule"), &scheduleList)...)
+	if scheduleList.IsNull() || scheduleList.IsUnknown() {
 		return
 	}
+	resp.Diagnostics.Append(scheduleList.ElementsAs(ctx, &schedules, false)...)
+
+	scheduleModels := make([]RangerPolicyValidityScheduleModel, 0, len(schedules))
+	for _, schedule := range schedules {
+		scheduleModels = append(scheduleModels, RangerPolicyValidityScheduleModel{
+			StartTime: schedule.StartTime,
+			EndTime:   schedule.EndTime,
+			TimeZone:  schedule.TimeZone,
+		})
+	}
+	resp.Diagnostics.Append(validateValiditySchedules(scheduleModels)...)
+}
 
-	// Prepare for API request
-	policyJSON, err := json.Marshal(policy)
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error Creating Ranger Policy",
-			fmt.Sprintf("Could not marshal policy JSON: %s", err),
-		)
+// ModifyPlan checks the planned policy against the definition of its service
+// type, so that rules Ranger would reject or ignore fail at plan time. When
+// the provider sets validate_principals, the principals of the policy items
+// are checked to exist too.
+func (r *rangerPolicyResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to check on destroy, or before the provider is configured
+	if req.Plan.Raw.IsNull() || r.client == nil {
 		return
 	}
 
-	url := fmt.Sprintf("%s/service/public/v2/api/policy", r.client.Endpoint)
-	request, err := http.NewRequest("POST", url, strings.NewReader(string(policyJSON)))
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error Creating Ranger Policy",
-			fmt.Sprintf("Could not create request: %s", err),
-		)
+	if r.client.ValidatePrincipals {
+		resp.Diagnostics.Append(validatePolicyPrincipals(ctx, req.Plan, r.client)...)
+	}
+
+	var service types.String
+	diags := req.Plan.GetAttribute(ctx, path.Root("service"), &service)
+	resp.Diagnostics.Append(diags...)
+	if diags.HasError() || service.IsUnknown() || service.IsNull() {
 		return
 	}
 
-	request.Header.Set("Authorization", r.client.AuthHeader)
-	request.Header.Set("Content-Type", "application/json")
-	request.Header.Set("Accept", "application/json")
+	serviceDef, diags := r.serviceDef(ctx, service.ValueString())
+	resp.Diagnostics.Append(diags...)
+	if serviceDef == nil {
+		return
+	}
 
-	// Execute the API request
-	response, err := r.client.Client.Do(request)
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error Creating Ranger Policy",
-			fmt.Sprintf("Could not execute API request: %s", err),
-		)
+	resp.Diagnostics.Append(validatePolicyPlan(ctx, req.Plan, serviceDef)...)
+}
+
+// Create creates a new Ranger policy.
+func (r *rangerPolicyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan RangerPolicyResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
-	defer response.Body.Close()
 
-	// Check the response
-	if response.StatusCode != http.StatusOK && response.StatusCode != http.StatusCreated {
-		resp.Diagnostics.AddError(
-			"Error Creating Ranger Policy",
-			fmt.Sprintf("API returned unexpected status code: %d", response.StatusCode),
-		)
+	// Convert the plan to a Ranger policy
+	policy, diags := r.convertModelToPolicy(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	// Decode the response
-	var createdPolicy Policy
-	err = json.NewDecoder(response.Body).Decode(&createdPolicy)
+	createdPolicy, err := r.client.CreatePolicy(ctx, &policy)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Creating Ranger Policy",
-			fmt.Sprintf("Could not decode API response: %s", err),
+			fmt.Sprintf("Could not create policy %q: %s", policy.Name, err),
 		)
 		return
 	}
 
-	// Update the plan with the created policy ID
+	// Update the plan with the created policy ID and the values Ranger chose
 	plan.ID = types.StringValue(fmt.Sprintf("%d", createdPolicy.ID))
+	resp.Diagnostics.Append(r.setComputedPolicyFields(ctx, &plan, *createdPolicy)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	// Update the terraform state
 	diags = resp.State.Set(ctx, plan)
@@ -388,64 +610,38 @@ func (r *rangerPolicyResource) Read(ctx context.Context, req resource.ReadReques
 		return
 	}
 
-	// Prepare for API request
-	url := fmt.Sprintf("%s/service/public/v2/api/policy/%s", r.client.Endpoint, state.ID.ValueString())
-	request, err := http.NewRequest("GET", url, nil)
+	id, err := parseInt64(state.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Reading Ranger Policy",
-			fmt.Sprintf("Could not create request: %s", err),
-		)
-		return
-	}
-
-	request.Header.Set("Authorization", r.client.AuthHeader)
-	request.Header.Set("Accept", "application/json")
-
-	// Execute the API request
-	response, err := r.client.Client.Do(request)
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error Reading Ranger Policy",
-			fmt.Sprintf("Could not execute API request: %s", err),
+			fmt.Sprintf("Could not parse policy ID: %s", err),
 		)
 		return
 	}
-	defer response.Body.Close()
 
-	// Check if the policy exists
-	if response.StatusCode == http.StatusNotFound {
+	policy, err := r.client.GetPolicy(ctx, id)
+	if ranger.IsNotFound(err) {
 		resp.State.RemoveResource(ctx)
 		return
 	}
-
-	// Check for other errors
-	if response.StatusCode != http.StatusOK {
-		resp.Diagnostics.AddError(
-			"Error Reading Ranger Policy",
-			fmt.Sprintf("API returned unexpected status code: %d", response.StatusCode),
-		)
-		return
-	}
-
-	// Decode the response
-	var policy Policy
-	err = json.NewDecoder(response.Body).Decode(&policy)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Reading Ranger Policy",
-			fmt.Sprintf("Could not decode API response: %s", err),
+			fmt.Sprintf("Could not read policy ID %d: %s", id, err),
 		)
 		return
 	}
 
 	// Convert the policy to the model
-	model, diags := r.convertPolicyToModel(ctx, policy)
+	model, diags := r.convertPolicyToModel(ctx, *policy)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	// Keep schedule times as configured when Ranger returns them reformatted
+	model.ValiditySchedules = reconcileValiditySchedules(state.ValiditySchedules, model.ValiditySchedules)
+
 	// Update the terraform state
 	diags = resp.State.Set(ctx, model)
 	resp.Diagnostics.Append(diags...)
@@ -460,6 +656,13 @@ func (r *rangerPolicyResource) Update(ctx context.Context, req resource.UpdateRe
 		return
 	}
 
+	var state RangerPolicyResourceModel
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Convert the plan to a Ranger policy
 	policy, diags := r.convertModelToPolicy(ctx, plan)
 	resp.Diagnostics.Append(diags...)
@@ -468,8 +671,7 @@ func (r *rangerPolicyResource) Update(ctx context.Context, req resource.UpdateRe
 	}
 
 	// Set the policy ID for the update
-	policyID := plan.ID.ValueString()
-	id, err := parseInt64(policyID)
+	id, err := parseInt64(plan.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Updating Ranger Policy",
@@ -479,61 +681,64 @@ func (r *rangerPolicyResource) Update(ctx context.Context, req resource.UpdateRe
 	}
 	policy.ID = id
 
-	// Prepare for API request
-	policyJSON, err := json.Marshal(policy)
+	// Read-modify-write: only the managed attributes are overlaid on the
+	// current policy, so fields the provider does not model are kept
+	doc, err := r.client.GetPolicyDocument(ctx, id)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Updating Ranger Policy",
-			fmt.Sprintf("Could not marshal policy JSON: %s", err),
+			fmt.Sprintf("Could not read policy ID %d: %s", id, err),
 		)
 		return
 	}
 
-	url := fmt.Sprintf("%s/service/public/v2/api/policy/%s", r.client.Endpoint, policyID)
-	request, err := http.NewRequest("PUT", url, strings.NewReader(string(policyJSON)))
+	// Refuse to overwrite changes made since the last refresh
+	currentVersion, err := doc.Version()
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Updating Ranger Policy",
-			fmt.Sprintf("Could not create request: %s", err),
+			fmt.Sprintf("Could not read the version of policy ID %d: %s", id, err),
 		)
 		return
 	}
-
-	request.Header.Set("Authorization", r.client.AuthHeader)
-	request.Header.Set("Content-Type", "application/json")
-	request.Header.Set("Accept", "application/json")
-
-	// Execute the API request
-	response, err := r.client.Client.Do(request)
-	if err != nil {
+	if !state.Version.IsNull() && !state.Version.IsUnknown() && state.Version.ValueInt64() != currentVersion {
 		resp.Diagnostics.AddError(
-			"Error Updating Ranger Policy",
-			fmt.Sprintf("Could not execute API request: %s", err),
+			"Ranger Policy Changed Outside Terraform",
+			fmt.Sprintf("Policy ID %d changed outside Terraform since last refresh (version %d in state, version %d in Ranger). "+
+				"Run terraform plan again to review the changes before applying.", id, state.Version.ValueInt64(), currentVersion),
 		)
 		return
 	}
-	defer response.Body.Close()
+	policy.Version = currentVersion
+
+	unmanaged, diags := unmanagedPolicyFields(ctx, req.Config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	// Check the response
-	if response.StatusCode != http.StatusOK {
+	if err := doc.Merge(&policy, unmanaged...); err != nil {
 		resp.Diagnostics.AddError(
 			"Error Updating Ranger Policy",
-			fmt.Sprintf("API returned unexpected status code: %d", response.StatusCode),
+			fmt.Sprintf("Could not merge policy ID %d: %s", id, err),
 		)
 		return
 	}
 
-	// Decode the response to ensure it was successful
-	var updatedPolicy Policy
-	err = json.NewDecoder(response.Body).Decode(&updatedPolicy)
+	updatedPolicy, err := r.client.UpdatePolicyDocument(ctx, id, doc)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Updating Ranger Policy",
-			fmt.Sprintf("Could not decode API response: %s", err),
+			fmt.Sprintf("Could not update policy ID %d: %s", id, err),
 		)
 		return
 	}
 
+	resp.Diagnostics.Append(r.setComputedPolicyFields(ctx, &plan, *updatedPolicy)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Update the terraform state
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
@@ -556,101 +761,327 @@ func (r *rangerPolicyResource) Delete(ctx context.Context, req resource.DeleteRe
 		return
 	}
 
-	policyID := state.ID.ValueString()
-	url := fmt.Sprintf("%s/service/public/v2/api/policy/%s", r.client.Endpoint, policyID)
-
-	// Prepare for API request
-	request, err := http.NewRequest("DELETE", url, nil)
+	id, err := parseInt64(state.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Deleting Ranger Policy",
-			fmt.Sprintf("Could not create request: %s", err),
+			fmt.Sprintf("Could not parse policy ID: %s", err),
 		)
 		return
 	}
 
-	request.Header.Set("Authorization", r.client.AuthHeader)
-
-	// Execute the API request
-	response, err := r.client.Client.Do(request)
-	if err != nil {
+	err = r.client.DeletePolicy(ctx, id)
+	if err != nil && !ranger.IsNotFound(err) {
 		resp.Diagnostics.AddError(
 			"Error Deleting Ranger Policy",
-			fmt.Sprintf("Could not execute API request: %s", err),
+			fmt.Sprintf("Could not delete policy ID %d: %s", id, err),
 		)
 		return
 	}
-	defer response.Body.Close()
 
-	// Check if the API call was successful
-	if response.StatusCode != http.StatusOK && response.StatusCode != http.StatusNoContent {
+	tflog.Info(ctx, "Deleted Ranger policy", map[string]interface{}{
+		"id": id,
+	})
+}
+
+// ImportState imports a Ranger policy by ID, by service/policy_name or by
+// zone/service/policy_name. Policy names may contain "/", as HDFS path
+// policies often do, so both forms are tried and the import ID must match
+// exactly one policy. Import blocks take the same forms as their id; import
+// by resource identity is not supported.
+func (r *rangerPolicyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if _, err := parseInt64(req.ID); err == nil {
+		resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+		return
+	}
+
+	keys := policyImportKeys(req.ID)
+	if len(keys) == 0 {
 		resp.Diagnostics.AddError(
-			"Error Deleting Ranger Policy",
-			fmt.Sprintf("API returned unexpected status code: %d", response.StatusCode),
+			"Invalid Ranger Policy Import ID",
+			fmt.Sprintf("Expected a policy ID, service/policy_name or zone/service/policy_name, got %q.", req.ID),
 		)
 		return
 	}
 
-	tflog.Info(ctx, "Deleted Ranger policy", map[string]interface{}{
-		"id": policyID,
-	})
+	var found []*ranger.Policy
+	for _, key := range keys {
+		policy, err := r.client.GetPolicyByName(ctx, key.service, key.name, key.zone)
+		if ranger.IsNotFound(err) {
+			continue
+		}
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Importing Ranger Policy",
+				fmt.Sprintf("Could not read policy %q in service %q: %s", key.name, key.service, err),
+			)
+			return
+		}
+		found = append(found, policy)
+	}
+
+	switch {
+	case len(found) == 0:
+		resp.Diagnostics.AddError(
+			"Error Importing Ranger Policy",
+			fmt.Sprintf("No policy found for import ID %q, read as %s.", req.ID, describePolicyImportKeys(keys)),
+		)
+		return
+	case len(found) > 1 && found[0].ID != found[1].ID:
+		resp.Diagnostics.AddError(
+			"Ambiguous Ranger Policy Import ID",
+			fmt.Sprintf("The import ID %q matches policy %d and policy %d, read as %s. Import the policy by its numeric ID instead.",
+				req.ID, found[0].ID, found[1].ID, describePolicyImportKeys(keys)),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), fmt.Sprintf("%d", found[0].ID))...)
 }
 
-// ImportState imports a Ranger policy by ID.
-func (r *rangerPolicyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+// policyImportKey identifies a policy by zone, service and name.
+type policyImportKey struct {
+	zone, service, name string
+}
+
+// policyImportKeys returns the ways of reading an import ID as
+// service/policy_name and zone/service/policy_name, where the name is the
+// remainder of the ID and may contain "/".
+func policyImportKeys(id string) []policyImportKey {
+	var keys []policyImportKey
+
+	if parts := strings.SplitN(id, "/", 2); len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		keys = append(keys, policyImportKey{service: parts[0], name: parts[1]})
+	}
+	if parts := strings.SplitN(id, "/", 3); len(parts) == 3 && parts[0] != "" && parts[1] != "" && parts[2] != "" {
+		keys = append(keys, policyImportKey{zone: parts[0], service: parts[1], name: parts[2]})
+	}
+
+	return keys
+}
+
+// describePolicyImportKeys returns the keys in a form suitable for error messages.
+func describePolicyImportKeys(keys []policyImportKey) string {
+	descriptions := make([]string, 0, len(keys))
+	for _, key := range keys {
+		description := fmt.Sprintf("policy %q of service %q", key.name, key.service)
+		if key.zone != "" {
+			description += fmt.Sprintf(" in zone %q", key.zone)
+		}
+		descriptions = append(descriptions, description)
+	}
+	return strings.Join(descriptions, " or ")
+}
+
+// UpgradeState migrates state written by earlier versions of the resource schema.
+func (r *rangerPolicyResource) UpgradeState(_ context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		// Version 0 stored resources as a list of objects with a type attribute
+		0: {StateUpgrader: upgradePolicyState(upgradePolicyResourcesV0, upgradePolicyConditionsV1)},
+		// Version 1 stored conditions as a map of condition type to values
+		1: {StateUpgrader: upgradePolicyState(upgradePolicyConditionsV1)},
+	}
+}
+
+// upgradePolicyState returns a state upgrader applying steps, in order, to
+// the prior state decoded as raw JSON.
+func upgradePolicyState(steps ...func(state map[string]json.RawMessage) error) func(context.Context, resource.UpgradeStateRequest, *resource.UpgradeStateResponse) {
+	return func(_ context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+		if req.RawState == nil {
+			resp.Diagnostics.AddError("Error Upgrading Ranger Policy State", "No prior state to upgrade.")
+			return
+		}
+
+		var state map[string]json.RawMessage
+		if err := json.Unmarshal(req.RawState.JSON, &state); err != nil {
+			resp.Diagnostics.AddError(
+				"Error Upgrading Ranger Policy State",
+				fmt.Sprintf("Could not decode prior state: %s", err),
+			)
+			return
+		}
+
+		for _, step := range steps {
+			if err := step(state); err != nil {
+				resp.Diagnostics.AddError(
+					"Error Upgrading Ranger Policy State",
+					fmt.Sprintf("Could not upgrade prior state: %s", err),
+				)
+				return
+			}
+		}
+
+		upgradedState, err := json.Marshal(state)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Upgrading Ranger Policy State",
+				fmt.Sprintf("Could not encode state: %s", err),
+			)
+			return
+		}
+
+		resp.DynamicValue = &tfprotov6.DynamicValue{JSON: upgradedState}
+	}
+}
+
+// upgradePolicyResourcesV0 turns the resources list of version 0 into a map keyed by resource type.
+func upgradePolicyResourcesV0(state map[string]json.RawMessage) error {
+	var resources []map[string]json.RawMessage
+	if raw, ok := state["resources"]; ok {
+		if err := json.Unmarshal(raw, &resources); err != nil {
+			return fmt.Errorf("could not decode prior resources: %w", err)
+		}
+	}
+
+	upgraded := make(map[string]map[string]json.RawMessage, len(resources))
+	for _, res := range resources {
+		var resType string
+		if err := json.Unmarshal(res["type"], &resType); err != nil {
+			return fmt.Errorf("could not decode prior resource type: %w", err)
+		}
+		delete(res, "type")
+		upgraded[resType] = res
+	}
+
+	var err error
+	if state["resources"], err = json.Marshal(upgraded); err != nil {
+		return fmt.Errorf("could not encode resources: %w", err)
+	}
+	return nil
+}
+
+// upgradePolicyConditionsV1 turns the conditions map of the policy items of
+// version 1 into a list of condition objects.
+func upgradePolicyConditionsV1(state map[string]json.RawMessage) error {
+	for _, attribute := range []string{"policy_item", "deny_item", "allow_exception", "deny_exception"} {
+		raw, ok := state[attribute]
+		if !ok {
+			continue
+		}
+
+		var items []map[string]json.RawMessage
+		if err := json.Unmarshal(raw, &items); err != nil {
+			return fmt.Errorf("could not decode prior %s: %w", attribute, err)
+		}
+
+		for _, item := range items {
+			var conditions map[string][]string
+			if raw, ok := item["conditions"]; ok {
+				if err := json.Unmarshal(raw, &conditions); err != nil {
+					return fmt.Errorf("could not decode prior %s conditions: %w", attribute, err)
+				}
+				delete(item, "conditions")
+			}
+
+			type condition struct {
+				Type   string   `json:"type"`
+				Values []string `json:"values"`
+			}
+			var upgraded []condition
+			for condType, values := range conditions {
+				upgraded = append(upgraded, condition{Type: condType, Values: values})
+			}
+
+			var err error
+			if item["condition"], err = json.Marshal(upgraded); err != nil {
+				return fmt.Errorf("could not encode %s conditions: %w", attribute, err)
+			}
+		}
+
+		var err error
+		if state[attribute], err = json.Marshal(items); err != nil {
+			return fmt.Errorf("could not encode %s: %w", attribute, err)
+		}
+	}
+	return nil
 }
 
 // Helper functions
 
 // convertModelToPolicy converts a Terraform model to a Ranger policy.
-func (r *rangerPolicyResource) convertModelToPolicy(ctx context.Context, model RangerPolicyResourceModel) (Policy, diag.Diagnostics) {
+func (r *rangerPolicyResource) convertModelToPolicy(ctx context.Context, model RangerPolicyResourceModel) (ranger.Policy, diag.Diagnostics) {
 	var diags diag.Diagnostics
-	policy := Policy{
+	policy := ranger.Policy{
 		Name:           model.Name.ValueString(),
 		Service:        model.Service.ValueString(),
 		IsEnabled:      model.IsEnabled.ValueBool(),
 		IsAuditEnabled: model.IsAuditEnabled.ValueBool(),
 		PolicyType:     model.PolicyType.ValueInt64(),
-		Resources:      make(map[string]PolicyResources),
+		Resources:      make(map[string]ranger.PolicyResources),
 	}
 
 	if !model.Description.IsNull() {
 		policy.Description = model.Description.ValueString()
 	}
 
+	// Fields left unknown are not managed by Terraform and keep Ranger's defaults
+	if !model.PolicyPriority.IsNull() && !model.PolicyPriority.IsUnknown() {
+		policy.PolicyPriority = policyPriorities[model.PolicyPriority.ValueString()]
+	}
+	if !model.PolicyLabels.IsNull() && !model.PolicyLabels.IsUnknown() {
+		diags.Append(model.PolicyLabels.ElementsAs(ctx, &policy.PolicyLabels, false)...)
+	}
+	if !model.ZoneName.IsUnknown() {
+		policy.ZoneName = model.ZoneName.ValueString()
+	}
+	if !model.IsDenyAllElse.IsUnknown() {
+		policy.IsDenyAllElse = model.IsDenyAllElse.ValueBool()
+	}
+	if !model.Options.IsNull() && !model.Options.IsUnknown() {
+		options := make(map[string]string, len(model.Options.Elements()))
+		diags.Append(model.Options.ElementsAs(ctx, &options, false)...)
+		policy.Options = make(map[string]interface{}, len(options))
+		for key, value := range options {
+			policy.Options[key] = value
+		}
+	}
+
 	// Convert resources
-	for _, res := range model.Resources {
-		resType := res.Type.ValueString()
+	for resType, res := range model.Resources {
 		valuesStrings := make([]string, 0, len(res.Values))
 		for _, val := range res.Values {
 			valuesStrings = append(valuesStrings, val.ValueString())
 		}
 
-		policy.Resources[resType] = PolicyResources{
+		policy.Resources[resType] = ranger.PolicyResources{
 			Values:      valuesStrings,
 			IsExclude:   res.IsExclude.ValueBool(),
 			IsRecursive: res.IsRecursive.ValueBool(),
 		}
 	}
 
-	// Convert policy items (allow rules)
-	if len(model.PolicyItems) > 0 {
-		policy.PolicyItems = make([]PolicyItem, 0, len(model.PolicyItems))
-		for _, item := range model.PolicyItems {
-			policyItem, itemDiags := convertPolicyItemModel(item)
+	// Convert policy items (allow rules), deny items and their exceptions
+	var itemDiags diag.Diagnostics
+	policy.PolicyItems, itemDiags = convertPolicyItemModels(model.PolicyItems)
+	diags.Append(itemDiags...)
+	policy.DenyPolicyItems, itemDiags = convertPolicyItemModels(model.DenyItems)
+	diags.Append(itemDiags...)
+	policy.AllowExceptions, itemDiags = convertPolicyItemModels(model.AllowExceptions)
+	diags.Append(itemDiags...)
+	policy.DenyExceptions, itemDiags = convertPolicyItemModels(model.DenyExceptions)
+	diags.Append(itemDiags...)
+
+	// Convert validity schedules
+	policy.ValiditySchedules, itemDiags = convertValidityScheduleModels(model.ValiditySchedules)
+	diags.Append(itemDiags...)
+
+	// Convert data masking items
+	if len(model.DataMaskItems) > 0 {
+		policy.DataMaskPolicyItems = make([]ranger.DataMaskPolicyItem, 0, len(model.DataMaskItems))
+		for _, item := range model.DataMaskItems {
+			dataMaskItem, itemDiags := convertDataMaskItemModel(item)
 			diags.Append(itemDiags...)
-			policy.PolicyItems = append(policy.PolicyItems, policyItem)
+			policy.DataMaskPolicyItems = append(policy.DataMaskPolicyItems, dataMaskItem)
 		}
 	}
 
-	// Convert deny policy items
-	if len(model.DenyItems) > 0 {
-		policy.DenyPolicyItems = make([]PolicyItem, 0, len(model.DenyItems))
-		for _, item := range model.DenyItems {
-			policyItem, itemDiags := convertPolicyItemModel(item)
+	// Convert row filter items
+	if len(model.RowFilterItems) > 0 {
+		policy.RowFilterPolicyItems = make([]ranger.RowFilterPolicyItem, 0, len(model.RowFilterItems))
+		for _, item := range model.RowFilterItems {
+			rowFilterItem, itemDiags := convertRowFilterItemModel(item)
 			diags.Append(itemDiags...)
-			policy.DenyPolicyItems = append(policy.DenyPolicyItems, policyItem)
+			policy.RowFilterPolicyItems = append(policy.RowFilterPolicyItems, rowFilterItem)
 		}
 	}
 
@@ -658,7 +1089,7 @@ func (r *rangerPolicyResource) convertModelToPolicy(ctx context.Context, model R
 }
 
 // convertPolicyToModel converts a Ranger policy to a Terraform model.
-func (r *rangerPolicyResource) convertPolicyToModel(ctx context.Context, policy Policy) (RangerPolicyResourceModel, diag.Diagnostics) {
+func (r *rangerPolicyResource) convertPolicyToModel(ctx context.Context, policy ranger.Policy) (RangerPolicyResourceModel, diag.Diagnostics) {
 	var diags diag.Diagnostics
 	model := RangerPolicyResourceModel{
 		ID:             types.StringValue(fmt.Sprintf("%d", policy.ID)),
@@ -668,50 +1099,85 @@ func (r *rangerPolicyResource) convertPolicyToModel(ctx context.Context, policy
 		IsEnabled:      types.BoolValue(policy.IsEnabled),
 		IsAuditEnabled: types.BoolValue(policy.IsAuditEnabled),
 		PolicyType:     types.Int64Value(policy.PolicyType),
+		ZoneName:       types.StringValue(policy.ZoneName),
+		IsDenyAllElse:  types.BoolValue(policy.IsDenyAllElse),
+		ServiceType:    types.StringValue(policy.ServiceType),
+		Version:        types.Int64Value(policy.Version),
 	}
 
+	model.PolicyPriority = types.StringValue(policyPriorityName(policy.PolicyPriority))
+
+	labels := policy.PolicyLabels
+	if labels == nil {
+		labels = []string{}
+	}
+	policyLabels, labelDiags := types.SetValueFrom(ctx, types.StringType, labels)
+	diags.Append(labelDiags...)
+	model.PolicyLabels = policyLabels
+
+	options := make(map[string]string, len(policy.Options))
+	for key, value := range policy.Options {
+		options[key] = optionString(value)
+	}
+	policyOptions, optionDiags := types.MapValueFrom(ctx, types.StringType, options)
+	diags.Append(optionDiags...)
+	model.Options = policyOptions
+
 	// Convert resources
-	resources := make([]RangerPolicyResourcesModel, 0)
+	resources := make(map[string]RangerPolicyResourcesModel, len(policy.Resources))
 	for resType, resValue := range policy.Resources {
 		values := make([]types.String, 0, len(resValue.Values))
 		for _, val := range resValue.Values {
 			values = append(values, types.StringValue(val))
 		}
 
-		resources = append(resources, RangerPolicyResourcesModel{
-			Type:        types.StringValue(resType),
+		resources[resType] = RangerPolicyResourcesModel{
 			Values:      values,
 			IsExclude:   types.BoolValue(resValue.IsExclude),
 			IsRecursive: types.BoolValue(resValue.IsRecursive),
-		})
+		}
 	}
 	model.Resources = resources
 
-	// Convert policy items (allow rules)
-	policyItems := make([]RangerPolicyItemModel, 0, len(policy.PolicyItems))
-	for _, item := range policy.PolicyItems {
-		policyItem, itemDiags := convertPolicyItem(item)
-		diags.Append(itemDiags...)
-		policyItems = append(policyItems, policyItem)
+	// Convert policy items (allow rules), deny items and their exceptions
+	var itemDiags diag.Diagnostics
+	model.PolicyItems, itemDiags = convertPolicyItems(policy.PolicyItems, policy.Name, "policy_item")
+	diags.Append(itemDiags...)
+	model.DenyItems, itemDiags = convertPolicyItems(policy.DenyPolicyItems, policy.Name, "deny_item")
+	diags.Append(itemDiags...)
+	model.AllowExceptions, itemDiags = convertPolicyItems(policy.AllowExceptions, policy.Name, "allow_exception")
+	diags.Append(itemDiags...)
+	model.DenyExceptions, itemDiags = convertPolicyItems(policy.DenyExceptions, policy.Name, "deny_exception")
+	diags.Append(itemDiags...)
+
+	// Convert validity schedules
+	model.ValiditySchedules = convertValiditySchedules(policy.ValiditySchedules)
+
+	// Convert data masking items
+	var dataMaskItems []RangerPolicyDataMaskItemModel
+	for i, item := range policy.DataMaskPolicyItems {
+		dataMaskItem, itemDiags := convertDataMaskItem(item)
+		diags.Append(policyItemDiagnostics(itemDiags, policy.Name, "data_mask_item", i)...)
+		dataMaskItems = append(dataMaskItems, dataMaskItem)
 	}
-	model.PolicyItems = policyItems
-
-	// Convert deny policy items
-	denyItems := make([]RangerPolicyItemModel, 0, len(policy.DenyPolicyItems))
-	for _, item := range policy.DenyPolicyItems {
-		policyItem, itemDiags := convertPolicyItem(item)
-		diags.Append(itemDiags...)
-		denyItems = append(denyItems, policyItem)
+	model.DataMaskItems = dataMaskItems
+
+	// Convert row filter items
+	var rowFilterItems []RangerPolicyRowFilterItemModel
+	for i, item := range policy.RowFilterPolicyItems {
+		rowFilterItem, itemDiags := convertRowFilterItem(item)
+		diags.Append(policyItemDiagnostics(itemDiags, policy.Name, "row_filter_item", i)...)
+		rowFilterItems = append(rowFilterItems, rowFilterItem)
 	}
-	model.DenyItems = denyItems
+	model.RowFilterItems = rowFilterItems
 
 	return model, diags
 }
 
 // convertPolicyItemModel converts a Terraform policy item model to a Ranger policy item.
-func convertPolicyItemModel(itemModel RangerPolicyItemModel) (PolicyItem, diag.Diagnostics) {
+func convertPolicyItemModel(itemModel RangerPolicyItemModel) (ranger.PolicyItem, diag.Diagnostics) {
 	var diags diag.Diagnostics
-	policyItem := PolicyItem{
+	policyItem := ranger.PolicyItem{
 		DelegateAdmin: itemModel.DelegateAdmin.ValueBool(),
 	}
 
@@ -744,9 +1210,9 @@ func convertPolicyItemModel(itemModel RangerPolicyItemModel) (PolicyItem, diag.D
 
 	// Convert permissions to accesses
 	if len(itemModel.Permissions) > 0 {
-		accesses := make([]Access, 0, len(itemModel.Permissions))
+		accesses := make([]ranger.Access, 0, len(itemModel.Permissions))
 		for _, perm := range itemModel.Permissions {
-			accesses = append(accesses, Access{
+			accesses = append(accesses, ranger.Access{
 				Type:      perm.ValueString(),
 				IsAllowed: true,
 			})
@@ -756,18 +1222,17 @@ func convertPolicyItemModel(itemModel RangerPolicyItemModel) (PolicyItem, diag.D
 
 	// Convert conditions (if any)
 	if len(itemModel.Conditions) > 0 {
-		conditions := make([]map[string]interface{}, 0)
-		for condType, condValues := range itemModel.Conditions {
-			values := make([]string, 0, len(condValues))
-			for _, val := range condValues {
+		conditions := make([]ranger.PolicyItemCondition, 0, len(itemModel.Conditions))
+		for _, conditionModel := range itemModel.Conditions {
+			values := make([]string, 0, len(conditionModel.Values))
+			for _, val := range conditionModel.Values {
 				values = append(values, val.ValueString())
 			}
 
-			condition := map[string]interface{}{
-				"type":   condType,
-				"values": values,
-			}
-			conditions = append(conditions, condition)
+			conditions = append(conditions, ranger.PolicyItemCondition{
+				Type:   conditionModel.Type.ValueString(),
+				Values: values,
+			})
 		}
 		policyItem.Conditions = conditions
 	}
@@ -776,36 +1241,20 @@ func convertPolicyItemModel(itemModel RangerPolicyItemModel) (PolicyItem, diag.D
 }
 
 // convertPolicyItem converts a Ranger policy item to a Terraform policy item model.
-func convertPolicyItem(item PolicyItem) (RangerPolicyItemModel, diag.Diagnostics) {
+func convertPolicyItem(item ranger.PolicyItem) (RangerPolicyItemModel, diag.Diagnostics) {
 	var diags diag.Diagnostics
+
+	// Empty principals are left null so that items compare equal to
+	// configurations that omit them
 	policyItemModel := RangerPolicyItemModel{
+		Users:         stringValues(item.Users),
+		Groups:        stringValues(item.Groups),
+		Roles:         stringValues(item.Roles),
 		DelegateAdmin: types.BoolValue(item.DelegateAdmin),
-		Conditions:    make(map[string][]types.String),
 	}
 
-	// Convert users
-	users := make([]types.String, 0, len(item.Users))
-	for _, user := range item.Users {
-		users = append(users, types.StringValue(user))
-	}
-	policyItemModel.Users = users
-
-	// Convert groups
-	groups := make([]types.String, 0, len(item.Groups))
-	for _, group := range item.Groups {
-		groups = append(groups, types.StringValue(group))
-	}
-	policyItemModel.Groups = groups
-
-	// Convert roles
-	roles := make([]types.String, 0, len(item.Roles))
-	for _, role := range item.Roles {
-		roles = append(roles, types.StringValue(role))
-	}
-	policyItemModel.Roles = roles
-
 	// Convert accesses to permissions
-	permissions := make([]types.String, 0, len(item.Accesses))
+	var permissions []types.String
 	for _, access := range item.Accesses {
 		if access.IsAllowed {
 			permissions = append(permissions, types.StringValue(access.Type))
@@ -815,32 +1264,232 @@ func convertPolicyItem(item PolicyItem) (RangerPolicyItemModel, diag.Diagnostics
 
 	// Convert conditions (if any)
 	for _, condition := range item.Conditions {
-		condType, ok := condition["type"].(string)
-		if !ok {
+		if condition.Type == "" {
+			diags.AddError(
+				"Unsupported Ranger Policy Condition",
+				fmt.Sprintf("The policy has a condition without a type, with values %q. It cannot be represented in Terraform.", condition.Values),
+			)
 			continue
 		}
 
-		condValues, ok := condition["values"].([]interface{})
-		if !ok {
+		if len(condition.InvalidValues) > 0 {
+			diags.AddError(
+				"Unsupported Ranger Policy Condition",
+				fmt.Sprintf("The %q condition has values that are not strings: %s. Terraform only supports string values; "+
+					"fix the condition in Ranger, or the values would be lost when Terraform updates the policy.", condition.Type, strings.Join(condition.InvalidValues, ", ")),
+			)
 			continue
 		}
 
-		values := make([]types.String, 0, len(condValues))
-		for _, val := range condValues {
-			if strVal, ok := val.(string); ok {
-				values = append(values, types.StringValue(strVal))
-			}
+		values := make([]types.String, 0, len(condition.Values))
+		for _, val := range condition.Values {
+			values = append(values, types.StringValue(val))
 		}
 
-		policyItemModel.Conditions[condType] = values
+		policyItemModel.Conditions = append(policyItemModel.Conditions, RangerPolicyConditionModel{
+			Type:   types.StringValue(condition.Type),
+			Values: values,
+		})
 	}
 
 	return policyItemModel, diags
 }
 
-// Helper function to parse int64 from string
-func parseInt64(s string) (int64, error) {
-	var i int64
-	_, err := fmt.Sscanf(s, "%d", &i)
-	return i, err
+// setComputedPolicyFields fills the computed attributes of model that are
+// still unknown with the values Ranger returned.
+func (r *rangerPolicyResource) setComputedPolicyFields(ctx context.Context, model *RangerPolicyResourceModel, policy ranger.Policy) diag.Diagnostics {
+	stored, diags := r.convertPolicyToModel(ctx, policy)
+	if diags.HasError() {
+		return diags
+	}
+
+	if model.PolicyPriority.IsUnknown() {
+		model.PolicyPriority = stored.PolicyPriority
+	}
+	if model.PolicyLabels.IsUnknown() {
+		model.PolicyLabels = stored.PolicyLabels
+	}
+	if model.ZoneName.IsUnknown() {
+		model.ZoneName = stored.ZoneName
+	}
+	if model.IsDenyAllElse.IsUnknown() {
+		model.IsDenyAllElse = stored.IsDenyAllElse
+	}
+	if model.Options.IsUnknown() {
+		model.Options = stored.Options
+	}
+	model.ServiceType = stored.ServiceType
+	model.Version = stored.Version
+
+	return diags
+}
+
+// unmanagedPolicyFields returns the Ranger policy fields that must be kept as
+// stored in Ranger on update, because the configuration leaves them out or
+// they are owned by the server. The plan cannot tell, as it keeps the prior
+// state for attributes left out of the configuration.
+func unmanagedPolicyFields(ctx context.Context, config tfsdk.Config) ([]string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	fields := []string{"serviceType"}
+
+	optionalFields := []struct {
+		attribute string
+		field     string
+	}{
+		{"policy_priority", "policyPriority"},
+		{"policy_labels", "policyLabels"},
+		{"zone_name", "zoneName"},
+		{"is_deny_all_else", "isDenyAllElse"},
+		{"options", "options"},
+	}
+	for _, optional := range optionalFields {
+		var value attr.Value
+		diags.Append(config.GetAttribute(ctx, path.Root(optional.attribute), &value)...)
+		if value == nil || value.IsNull() {
+			fields = append(fields, optional.field)
+		}
+	}
+
+	return fields, diags
+}
+
+// policyPriorities maps the policy_priority values to Ranger's policyPriority.
+var policyPriorities = map[string]int64{
+	"normal":   ranger.PolicyPriorityNormal,
+	"override": ranger.PolicyPriorityOverride,
+}
+
+// policyPriorityName returns the policy_priority value of a Ranger policyPriority.
+func policyPriorityName(priority int64) string {
+	for name, value := range policyPriorities {
+		if value == priority {
+			return name
+		}
+	}
+	return fmt.Sprintf("%d", priority)
+}
+
+// optionString renders a policy option value, which Ranger allows to be any JSON value, as a string.
+func optionString(value interface{}) string {
+	if s, ok := value.(string); ok {
+		return s
+	}
+	encoded, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprintf("%v", value)
+	}
+	return string(encoded)
+}
+
+// convertPolicyItemModels converts a list of Terraform policy item models to Ranger policy items.
+func convertPolicyItemModels(itemModels []RangerPolicyItemModel) ([]ranger.PolicyItem, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	if len(itemModels) == 0 {
+		return nil, diags
+	}
+
+	items := make([]ranger.PolicyItem, 0, len(itemModels))
+	for _, itemModel := range itemModels {
+		item, itemDiags := convertPolicyItemModel(itemModel)
+		diags.Append(itemDiags...)
+		items = append(items, item)
+	}
+	return items, diags
+}
+
+// convertPolicyItems converts a list of Ranger policy items to Terraform
+// policy item models. Diagnostics name the policy and the attribute of the
+// items.
+func convertPolicyItems(items []ranger.PolicyItem, policyName, attribute string) ([]RangerPolicyItemModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var itemModels []RangerPolicyItemModel
+	for i, item := range items {
+		itemModel, itemDiags := convertPolicyItem(item)
+		diags.Append(policyItemDiagnostics(itemDiags, policyName, attribute, i)...)
+		itemModels = append(itemModels, itemModel)
+	}
+	return itemModels, diags
+}
+
+// policyItemDiagnostics returns diags with the policy item they are about
+// prepended to their details. Items are numbered in Ranger's order.
+func policyItemDiagnostics(diags diag.Diagnostics, policyName, attribute string, index int) diag.Diagnostics {
+	var located diag.Diagnostics
+	for _, d := range diags {
+		detail := fmt.Sprintf("In %s %d of policy %q: %s", attribute, index+1, policyName, d.Detail())
+		if d.Severity() == diag.SeverityError {
+			located.AddError(d.Summary(), detail)
+		} else {
+			located.AddWarning(d.Summary(), detail)
+		}
+	}
+	return located
+}
+
+// convertDataMaskItemModel converts a Terraform data masking item model to a Ranger data masking item.
+func convertDataMaskItemModel(itemModel RangerPolicyDataMaskItemModel) (ranger.DataMaskPolicyItem, diag.Diagnostics) {
+	policyItem, diags := convertPolicyItemModel(RangerPolicyItemModel{
+		Users:       itemModel.Users,
+		Groups:      itemModel.Groups,
+		Roles:       itemModel.Roles,
+		Permissions: itemModel.Permissions,
+	})
+
+	return ranger.DataMaskPolicyItem{
+		PolicyItem: policyItem,
+		DataMaskInfo: ranger.DataMaskInfo{
+			DataMaskType:  itemModel.DataMaskInfo.DataMaskType.ValueString(),
+			ConditionExpr: itemModel.DataMaskInfo.ConditionExpr.ValueString(),
+			ValueExpr:     itemModel.DataMaskInfo.ValueExpr.ValueString(),
+		},
+	}, diags
+}
+
+// convertDataMaskItem converts a Ranger data masking item to a Terraform data masking item model.
+func convertDataMaskItem(item ranger.DataMaskPolicyItem) (RangerPolicyDataMaskItemModel, diag.Diagnostics) {
+	policyItemModel, diags := convertPolicyItem(item.PolicyItem)
+
+	return RangerPolicyDataMaskItemModel{
+		Users:       policyItemModel.Users,
+		Groups:      policyItemModel.Groups,
+		Roles:       policyItemModel.Roles,
+		Permissions: policyItemModel.Permissions,
+		DataMaskInfo: RangerDataMaskInfoModel{
+			DataMaskType:  types.StringValue(item.DataMaskInfo.DataMaskType),
+			ConditionExpr: stringValueOrNull(item.DataMaskInfo.ConditionExpr),
+			ValueExpr:     stringValueOrNull(item.DataMaskInfo.ValueExpr),
+		},
+	}, diags
+}
+
+// convertRowFilterItemModel converts a Terraform row filter item model to a Ranger row filter item.
+func convertRowFilterItemModel(itemModel RangerPolicyRowFilterItemModel) (ranger.RowFilterPolicyItem, diag.Diagnostics) {
+	policyItem, diags := convertPolicyItemModel(RangerPolicyItemModel{
+		Users:       itemModel.Users,
+		Groups:      itemModel.Groups,
+		Roles:       itemModel.Roles,
+		Permissions: itemModel.Permissions,
+	})
+
+	return ranger.RowFilterPolicyItem{
+		PolicyItem: policyItem,
+		RowFilterInfo: ranger.RowFilterInfo{
+			FilterExpr: itemModel.RowFilterInfo.FilterExpr.ValueString(),
+		},
+	}, diags
+}
+
+// convertRowFilterItem converts a Ranger row filter item to a Terraform row filter item model.
+func convertRowFilterItem(item ranger.RowFilterPolicyItem) (RangerPolicyRowFilterItemModel, diag.Diagnostics) {
+	policyItemModel, diags := convertPolicyItem(item.PolicyItem)
+
+	return RangerPolicyRowFilterItemModel{
+		Users:       policyItemModel.Users,
+		Groups:      policyItemModel.Groups,
+		Roles:       policyItemModel.Roles,
+		Permissions: policyItemModel.Permissions,
+		RowFilterInfo: RangerRowFilterInfoModel{
+			FilterExpr: types.StringValue(item.RowFilterInfo.FilterExpr),
+		},
+	}, diags
 }
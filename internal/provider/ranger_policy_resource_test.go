@@ -0,0 +1,355 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-provider-scaffolding-framework/internal/ranger"
+)
+
+func TestUpgradePolicyStateV0(t *testing.T) {
+	req := resource.UpgradeStateRequest{
+		RawState: &tfprotov6.RawState{
+			JSON: []byte(`{
+				"id": "3",
+				"name": "sales",
+				"resources": [
+					{"type": "database", "values": ["sales"], "is_exclude": false, "is_recursive": false},
+					{"type": "table", "values": ["orders"], "is_exclude": false, "is_recursive": false}
+				]
+			}`),
+		},
+	}
+	resp := &resource.UpgradeStateResponse{}
+
+	upgraders := (&rangerPolicyResource{}).UpgradeState(context.Background())
+	upgraders[0].StateUpgrader(context.Background(), req, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+
+	var state struct {
+		ID        string                                `json:"id"`
+		Resources map[string]map[string]json.RawMessage `json:"resources"`
+	}
+	if err := json.Unmarshal(resp.DynamicValue.JSON, &state); err != nil {
+		t.Fatalf("could not decode upgraded state: %s", err)
+	}
+
+	if state.ID != "3" {
+		t.Errorf("unrelated attributes were not kept: %s", resp.DynamicValue.JSON)
+	}
+	keys := make([]string, 0, len(state.Resources))
+	for key, res := range state.Resources {
+		keys = append(keys, key)
+		if _, ok := res["type"]; ok {
+			t.Errorf("type attribute was not removed from %q", key)
+		}
+	}
+	if len(keys) != 2 || state.Resources["table"] == nil || !reflect.DeepEqual(state.Resources["table"]["values"], json.RawMessage(`["orders"]`)) {
+		t.Errorf("unexpected upgraded resources: %s", resp.DynamicValue.JSON)
+	}
+}
+
+func TestUpgradePolicyStateV1(t *testing.T) {
+	req := resource.UpgradeStateRequest{
+		RawState: &tfprotov6.RawState{
+			JSON: []byte(`{
+				"id": "3",
+				"policy_item": [
+					{"groups": ["batch"], "permissions": ["select"], "conditions": {"ip-range": ["10.0.0.0/8"]}},
+					{"groups": ["analysts"], "permissions": ["select"], "conditions": null}
+				]
+			}`),
+		},
+	}
+	resp := &resource.UpgradeStateResponse{}
+
+	upgraders := (&rangerPolicyResource{}).UpgradeState(context.Background())
+	upgraders[1].StateUpgrader(context.Background(), req, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+
+	var state struct {
+		PolicyItems []map[string]json.RawMessage `json:"policy_item"`
+	}
+	if err := json.Unmarshal(resp.DynamicValue.JSON, &state); err != nil {
+		t.Fatalf("could not decode upgraded state: %s", err)
+	}
+
+	if len(state.PolicyItems) != 2 {
+		t.Fatalf("unexpected upgraded policy items: %s", resp.DynamicValue.JSON)
+	}
+	for _, item := range state.PolicyItems {
+		if _, ok := item["conditions"]; ok {
+			t.Errorf("conditions attribute was not removed: %s", resp.DynamicValue.JSON)
+		}
+	}
+	if got := string(state.PolicyItems[0]["condition"]); got != `[{"type":"ip-range","values":["10.0.0.0/8"]}]` {
+		t.Errorf("unexpected upgraded condition: %s", got)
+	}
+	if got := string(state.PolicyItems[1]["condition"]); got != "null" {
+		t.Errorf("expected no condition, got %s", got)
+	}
+}
+
+func TestConvertPolicyItemEmptyPrincipals(t *testing.T) {
+	itemModel, diags := convertPolicyItem(ranger.PolicyItem{
+		Groups:   []string{"analysts"},
+		Users:    []string{},
+		Accesses: []ranger.Access{{Type: "select", IsAllowed: true}},
+	})
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	// Null rather than empty, so the set element matches a config omitting them
+	if itemModel.Users != nil || itemModel.Roles != nil || itemModel.Conditions != nil {
+		t.Errorf("expected empty principals and conditions to be null: %+v", itemModel)
+	}
+	if len(itemModel.Groups) != 1 || len(itemModel.Permissions) != 1 {
+		t.Errorf("unexpected item: %+v", itemModel)
+	}
+}
+
+func TestPolicyResourceExclusionRoundTrip(t *testing.T) {
+	data, err := os.ReadFile("testdata/policy_hdfs_exclude.json")
+	if err != nil {
+		t.Fatalf("could not read recorded policy: %s", err)
+	}
+
+	var policy ranger.Policy
+	if err := json.Unmarshal(data, &policy); err != nil {
+		t.Fatalf("could not unmarshal recorded policy: %s", err)
+	}
+
+	r := &rangerPolicyResource{}
+	model, diags := r.convertPolicyToModel(context.Background(), policy)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	path, ok := model.Resources["path"]
+	if !ok || !path.IsExclude.ValueBool() || !path.IsRecursive.ValueBool() {
+		t.Fatalf("exclusion was not read from the policy: %+v", model.Resources)
+	}
+
+	converted, diags := r.convertModelToPolicy(context.Background(), model)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	encoded, err := json.Marshal(converted.Resources)
+	if err != nil {
+		t.Fatalf("could not marshal resources: %s", err)
+	}
+	want := `{"path":{"values":["/data/secure","/data/restricted"],"isExcludes":true,"isRecursive":true}}`
+	if string(encoded) != want {
+		t.Errorf("unexpected resources sent to Ranger:\n got: %s\nwant: %s", encoded, want)
+	}
+}
+
+func TestConvertPolicyItemConditions(t *testing.T) {
+	var item ranger.PolicyItem
+	err := json.Unmarshal([]byte(`{
+		"groups": ["analysts"],
+		"accesses": [{"type": "select", "isAllowed": true}],
+		"conditions": [
+			{"type": "ip-range", "values": ["10.0.0.0/8", "192.168.0.0/16"]},
+			{"type": "_expression", "values": ["USER.dept == 'sales'"]}
+		]
+	}`), &item)
+	if err != nil {
+		t.Fatalf("could not unmarshal policy item: %s", err)
+	}
+
+	itemModel, diags := convertPolicyItem(item)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	converted, diags := convertPolicyItemModel(itemModel)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if !reflect.DeepEqual(converted.Conditions, item.Conditions) {
+		t.Errorf("conditions did not round-trip:\n got: %+v\nwant: %+v", converted.Conditions, item.Conditions)
+	}
+
+	// Ranger only stores string values, anything else must not be dropped
+	// silently nor keep the policy from being read
+	var policy ranger.Policy
+	err = json.Unmarshal([]byte(`{"name": "sales", "policyItems": [
+		{"accesses": [{"type": "select", "isAllowed": true}]},
+		{"accesses": [{"type": "select", "isAllowed": true}], "conditions": [{"type": "ip-range", "values": ["10.0.0.0/8", 10, null]}]}
+	]}`), &policy)
+	if err != nil {
+		t.Fatalf("could not unmarshal policy: %s", err)
+	}
+	if got := policy.PolicyItems[1].Conditions[0].InvalidValues; !reflect.DeepEqual(got, []string{"10", "null"}) {
+		t.Errorf("unexpected invalid values: %q", got)
+	}
+
+	_, diags = (&rangerPolicyResource{}).convertPolicyToModel(context.Background(), policy)
+	if diags.ErrorsCount() != 1 {
+		t.Fatalf("expected an error for the non-string condition values, got: %v", diags)
+	}
+	if detail := diags[0].Detail(); !strings.Contains(detail, `policy_item 2 of policy "sales"`) || !strings.Contains(detail, `"ip-range" condition`) {
+		t.Errorf("error does not locate the condition: %s", detail)
+	}
+
+	_, diags = convertPolicyItem(ranger.PolicyItem{Conditions: []ranger.PolicyItemCondition{{Values: []string{"x"}}}})
+	if !diags.HasError() {
+		t.Errorf("expected an error for a condition without a type")
+	}
+}
+
+func TestPolicyValidateConfigUnknownPolicyType(t *testing.T) {
+	ctx := context.Background()
+	model := RangerPolicyResourceModel{
+		ID:             types.StringNull(),
+		Name:           types.StringValue("sales"),
+		Service:        types.StringValue("hive_prod"),
+		Description:    types.StringNull(),
+		IsEnabled:      types.BoolNull(),
+		IsAuditEnabled: types.BoolNull(),
+		Resources: map[string]RangerPolicyResourcesModel{
+			"database": {Values: []types.String{types.StringValue("sales")}, IsExclude: types.BoolNull(), IsRecursive: types.BoolNull()},
+		},
+		ValiditySchedules: []RangerPolicyValidityScheduleModel{
+			{StartTime: types.StringValue("next tuesday"), EndTime: types.StringNull(), TimeZone: types.StringNull()},
+		},
+		PolicyType:     types.Int64Unknown(),
+		PolicyPriority: types.StringValue("urgent"),
+		PolicyLabels:   types.SetNull(types.StringType),
+		ZoneName:       types.StringNull(),
+		IsDenyAllElse:  types.BoolNull(),
+		Options:        types.MapNull(types.StringType),
+		ServiceType:    types.StringNull(),
+		Version:        types.Int64Null(),
+	}
+	plan := testPolicyPlan(t, model)
+
+	resp := &resource.ValidateConfigResponse{}
+	(&rangerPolicyResource{}).ValidateConfig(ctx, resource.ValidateConfigRequest{
+		Config: tfsdk.Config{Schema: plan.Schema, Raw: plan.Raw},
+	}, resp)
+
+	// Only the item kinds depend on the policy type
+	if resp.Diagnostics.ErrorsCount() != 2 {
+		t.Errorf("expected the priority and schedule errors, got: %v", resp.Diagnostics)
+	}
+}
+
+func TestUnmanagedPolicyFieldsRemovedFromConfig(t *testing.T) {
+	ctx := context.Background()
+	model := RangerPolicyResourceModel{
+		ID:             types.StringNull(),
+		Name:           types.StringValue("sales"),
+		Service:        types.StringValue("hive_prod"),
+		Description:    types.StringNull(),
+		IsEnabled:      types.BoolNull(),
+		IsAuditEnabled: types.BoolNull(),
+		Resources: map[string]RangerPolicyResourcesModel{
+			"database": {Values: []types.String{types.StringValue("sales")}, IsExclude: types.BoolNull(), IsRecursive: types.BoolNull()},
+		},
+		PolicyType:     types.Int64Null(),
+		PolicyPriority: types.StringValue("override"),
+		PolicyLabels:   types.SetValueMust(types.StringType, []attr.Value{types.StringValue("pii")}),
+		ZoneName:       types.StringValue("finance"),
+		IsDenyAllElse:  types.BoolValue(true),
+		Options:        types.MapValueMust(types.StringType, map[string]attr.Value{}),
+		ServiceType:    types.StringNull(),
+		Version:        types.Int64Null(),
+	}
+	config := func(model RangerPolicyResourceModel) tfsdk.Config {
+		plan := testPolicyPlan(t, model)
+		return tfsdk.Config{Schema: plan.Schema, Raw: plan.Raw}
+	}
+
+	fields, diags := unmanagedPolicyFields(ctx, config(model))
+	if diags.HasError() {
+		t.Fatalf("unexpected errors: %v", diags)
+	}
+	if !reflect.DeepEqual(fields, []string{"serviceType"}) {
+		t.Errorf("expected configured fields to be managed, got %v", fields)
+	}
+
+	// Removing an attribute from the configuration keeps what Ranger has,
+	// even though the plan keeps the prior state, while an explicit empty
+	// value clears it
+	model.PolicyLabels = types.SetNull(types.StringType)
+	model.IsDenyAllElse = types.BoolNull()
+	model.Options = types.MapValueMust(types.StringType, map[string]attr.Value{})
+	fields, diags = unmanagedPolicyFields(ctx, config(model))
+	if diags.HasError() {
+		t.Fatalf("unexpected errors: %v", diags)
+	}
+	if !reflect.DeepEqual(fields, []string{"serviceType", "policyLabels", "isDenyAllElse"}) {
+		t.Errorf("unexpected unmanaged fields: %v", fields)
+	}
+}
+
+func TestPolicyImportKeys(t *testing.T) {
+	tests := map[string][]policyImportKey{
+		"hive/sales":         {{service: "hive", name: "sales"}},
+		"finance/hive/sales": {{service: "finance", name: "hive/sales"}, {zone: "finance", service: "hive", name: "sales"}},
+		"hdfs//data/finance": {{service: "hdfs", name: "/data/finance"}},
+		"sales":              nil,
+		"/sales":             nil,
+	}
+
+	for id, expected := range tests {
+		if got := policyImportKeys(id); !reflect.DeepEqual(got, expected) {
+			t.Errorf("policyImportKeys(%q) = %+v, want %+v", id, got, expected)
+		}
+	}
+}
+
+func TestPolicyImportStatePathName(t *testing.T) {
+	ctx := context.Background()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.EscapedPath() == "/service/public/v2/api/service/hdfs/policy/data%2Ffinance" && r.URL.Query().Get("zoneName") == "" {
+			_, _ = w.Write([]byte(`{"id":12,"name":"data/finance","service":"hdfs"}`))
+			return
+		}
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	t.Cleanup(server.Close)
+
+	r := &rangerPolicyResource{client: &RangerClient{Client: ranger.NewClient(ranger.Config{Endpoint: server.URL})}}
+
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+	resp := &resource.ImportStateResponse{
+		State: tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)},
+	}
+	r.ImportState(ctx, resource.ImportStateRequest{ID: "hdfs/data/finance"}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected errors: %v", resp.Diagnostics)
+	}
+
+	var id types.String
+	resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("id"), &id)...)
+	if id.ValueString() != "12" {
+		t.Errorf("unexpected imported ID %q", id.ValueString())
+	}
+}
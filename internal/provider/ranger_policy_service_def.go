@@ -0,0 +1,185 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-scaffolding-framework/internal/ranger"
+)
+
+// serviceDef returns the definition of the type of the given service. It
+// returns nil without error when the service does not exist yet, as it may be
+// created in the same run.
+func (r *rangerPolicyResource) serviceDef(ctx context.Context, serviceName string) (*ranger.ServiceDef, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	service, err := r.client.GetServiceByName(ctx, serviceName)
+	if ranger.IsNotFound(err) {
+		return nil, diags
+	}
+	if err != nil {
+		diags.AddError(
+			"Error Reading Ranger Service",
+			fmt.Sprintf("Could not read service %q: %s", serviceName, err),
+		)
+		return nil, diags
+	}
+
+	serviceDef, err := r.client.GetServiceDefByName(ctx, service.Type)
+	if err != nil {
+		diags.AddError(
+			"Error Reading Ranger Service Definition",
+			fmt.Sprintf("Could not read service definition %q of service %q: %s", service.Type, serviceName, err),
+		)
+		return nil, diags
+	}
+
+	return serviceDef, diags
+}
+
+// validatePolicyPlan checks that the resources, permissions and conditions of
+// a planned policy are defined by the service-def of its service. Data
+// masking and row filter policies are checked against the resources and
+// access types of the matching section of the service-def.
+//
+// The plan is walked as values rather than decoded into the model, so that
+// unknown values elsewhere in it do not get in the way. Unknown values are
+// not checked.
+func validatePolicyPlan(ctx context.Context, plan tfsdk.Plan, serviceDef *ranger.ServiceDef) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var policyType types.Int64
+	diags.Append(plan.GetAttribute(ctx, path.Root("policy_type"), &policyType)...)
+	if diags.HasError() || policyType.IsUnknown() {
+		return diags
+	}
+
+	resourceDefs, accessTypeDefs := serviceDef.Resources, serviceDef.AccessTypes
+	switch policyType.ValueInt64() {
+	case ranger.PolicyTypeDataMask:
+		if serviceDef.DataMaskDef == nil || len(serviceDef.DataMaskDef.AccessTypes) == 0 {
+			diags.AddAttributeError(
+				path.Root("policy_type"),
+				"Unsupported Policy Type",
+				fmt.Sprintf("The %q service definition does not support data masking policies.", serviceDef.Name),
+			)
+			return diags
+		}
+		resourceDefs, accessTypeDefs = serviceDef.DataMaskDef.Resources, serviceDef.DataMaskDef.AccessTypes
+	case ranger.PolicyTypeRowFilter:
+		if serviceDef.RowFilterDef == nil || len(serviceDef.RowFilterDef.AccessTypes) == 0 {
+			diags.AddAttributeError(
+				path.Root("policy_type"),
+				"Unsupported Policy Type",
+				fmt.Sprintf("The %q service definition does not support row filter policies.", serviceDef.Name),
+			)
+			return diags
+		}
+		resourceDefs, accessTypeDefs = serviceDef.RowFilterDef.Resources, serviceDef.RowFilterDef.AccessTypes
+	}
+
+	resourceNames := make([]string, 0, len(resourceDefs))
+	for _, def := range resourceDefs {
+		resourceNames = append(resourceNames, def.Name)
+	}
+	accessTypeNames := make([]string, 0, len(accessTypeDefs))
+	for _, def := range accessTypeDefs {
+		accessTypeNames = append(accessTypeNames, def.Name)
+	}
+	conditionNames := make([]string, 0, len(serviceDef.PolicyConditions))
+	for _, def := range serviceDef.PolicyConditions {
+		conditionNames = append(conditionNames, def.Name)
+	}
+
+	var resources types.Map
+	diags.Append(plan.GetAttribute(ctx, path.Root("resources"), &resources)...)
+	for resType := range resources.Elements() {
+		if !containsString(resourceNames, resType) {
+			diags.AddAttributeError(
+				path.Root("resources").AtMapKey(resType),
+				"Unknown Policy Resource",
+				fmt.Sprintf("Resource %q is not defined by the %q service definition. Valid resources: %s.", resType, serviceDef.Name, quotedList(resourceNames)),
+			)
+		}
+	}
+
+	items, itemDiags := plannedPolicyItems(ctx, plan)
+	diags.Append(itemDiags...)
+
+	for _, item := range items {
+		attributes := item.value.Attributes()
+
+		for _, permission := range knownStrings(attributes["permissions"]) {
+			if !containsString(accessTypeNames, permission.ValueString()) {
+				diags.AddAttributeError(
+					item.path.AtName("permissions").AtSetValue(permission),
+					"Unknown Policy Permission",
+					fmt.Sprintf("Access type %q is not defined by the %q service definition. Valid access types: %s.", permission.ValueString(), serviceDef.Name, quotedList(accessTypeNames)),
+				)
+			}
+		}
+
+		conditions, ok := attributes["condition"].(types.Set)
+		if !ok {
+			continue
+		}
+		for _, condition := range conditions.Elements() {
+			conditionObject, ok := condition.(types.Object)
+			if !ok {
+				continue
+			}
+
+			condType, ok := conditionObject.Attributes()["type"].(types.String)
+			if !ok || condType.IsNull() || condType.IsUnknown() || containsString(conditionNames, condType.ValueString()) {
+				continue
+			}
+			diags.AddAttributeError(
+				item.path.AtName("condition").AtSetValue(condition).AtName("type"),
+				"Unsupported Policy Condition",
+				fmt.Sprintf("Condition type %q is not defined by the %q service definition. Supported condition types: %s.", condType.ValueString(), serviceDef.Name, quotedList(conditionNames)),
+			)
+		}
+	}
+
+	return diags
+}
+
+// policyItemValue is a planned policy item together with its path.
+type policyItemValue struct {
+	path  path.Path
+	value types.Object
+}
+
+// plannedPolicyItems returns the items of every kind of a planned policy.
+func plannedPolicyItems(ctx context.Context, plan tfsdk.Plan) ([]policyItemValue, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var items []policyItemValue
+
+	for _, attribute := range []string{"policy_item", "deny_item", "allow_exception", "deny_exception"} {
+		var set types.Set
+		diags.Append(plan.GetAttribute(ctx, path.Root(attribute), &set)...)
+		for _, element := range set.Elements() {
+			if object, ok := element.(types.Object); ok {
+				items = append(items, policyItemValue{path.Root(attribute).AtSetValue(element), object})
+			}
+		}
+	}
+	for _, attribute := range []string{"data_mask_item", "row_filter_item"} {
+		var list types.List
+		diags.Append(plan.GetAttribute(ctx, path.Root(attribute), &list)...)
+		for i, element := range list.Elements() {
+			if object, ok := element.(types.Object); ok {
+				items = append(items, policyItemValue{path.Root(attribute).AtListIndex(i), object})
+			}
+		}
+	}
+
+	return items, diags
+}
@@ -0,0 +1,102 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-provider-scaffolding-framework/internal/ranger"
+)
+
+// testPolicyPlan returns a plan holding the given policy model.
+func testPolicyPlan(t *testing.T, model RangerPolicyResourceModel) tfsdk.Plan {
+	t.Helper()
+	ctx := context.Background()
+
+	schemaResp := &resource.SchemaResponse{}
+	(&rangerPolicyResource{}).Schema(ctx, resource.SchemaRequest{}, schemaResp)
+
+	plan := tfsdk.Plan{
+		Schema: schemaResp.Schema,
+		Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+	}
+	if diags := plan.Set(ctx, &model); diags.HasError() {
+		t.Fatalf("could not build plan: %v", diags)
+	}
+	return plan
+}
+
+func TestValidatePolicyPlan(t *testing.T) {
+	serviceDef := &ranger.ServiceDef{
+		Name:             "hive",
+		Resources:        []ranger.ResourceDef{{Name: "database"}, {Name: "table"}, {Name: "column"}},
+		AccessTypes:      []ranger.AccessTypeDef{{Name: "select"}, {Name: "update"}},
+		PolicyConditions: []ranger.PolicyConditionDef{{Name: "ip-range"}},
+	}
+
+	model := RangerPolicyResourceModel{
+		ID:             types.StringUnknown(),
+		Name:           types.StringValue("sales"),
+		Service:        types.StringValue("hive_prod"),
+		Description:    types.StringNull(),
+		IsEnabled:      types.BoolValue(true),
+		IsAuditEnabled: types.BoolValue(true),
+		Resources: map[string]RangerPolicyResourcesModel{
+			"database": {Values: []types.String{types.StringValue("sales")}, IsExclude: types.BoolValue(false), IsRecursive: types.BoolValue(false)},
+			"tabel":    {Values: []types.String{types.StringValue("orders")}, IsExclude: types.BoolValue(false), IsRecursive: types.BoolValue(false)},
+		},
+		PolicyItems: []RangerPolicyItemModel{
+			{
+				Groups:        []types.String{types.StringValue("analysts")},
+				Permissions:   []types.String{types.StringValue("select"), types.StringValue("selct")},
+				DelegateAdmin: types.BoolValue(false),
+				Conditions: []RangerPolicyConditionModel{
+					{Type: types.StringValue("ip-range"), Values: []types.String{types.StringValue("10.0.0.0/8")}},
+					{Type: types.StringValue("geo"), Values: []types.String{types.StringValue("EU")}},
+				},
+			},
+		},
+		PolicyType:     types.Int64Value(ranger.PolicyTypeAccess),
+		PolicyPriority: types.StringUnknown(),
+		PolicyLabels:   types.SetUnknown(types.StringType),
+		ZoneName:       types.StringUnknown(),
+		IsDenyAllElse:  types.BoolUnknown(),
+		Options:        types.MapUnknown(types.StringType),
+		ServiceType:    types.StringUnknown(),
+		Version:        types.Int64Unknown(),
+	}
+
+	diags := validatePolicyPlan(context.Background(), testPolicyPlan(t, model), serviceDef)
+	if diags.ErrorsCount() != 3 {
+		t.Fatalf("expected 3 errors (resource, permission, condition), got %d: %v", diags.ErrorsCount(), diags)
+	}
+
+	paths := make([]path.Path, 0, len(diags))
+	for _, d := range diags {
+		if withPath, ok := d.(interface{ Path() path.Path }); ok {
+			paths = append(paths, withPath.Path())
+		}
+	}
+	if len(paths) != 3 || !paths[0].Equal(path.Root("resources").AtMapKey("tabel")) {
+		t.Errorf("unexpected error paths: %v", paths)
+	}
+
+	// Data masking policies are checked against the dataMaskDef
+	model.Resources = map[string]RangerPolicyResourcesModel{
+		"database": {Values: []types.String{types.StringValue("sales")}, IsExclude: types.BoolValue(false), IsRecursive: types.BoolValue(false)},
+	}
+	model.PolicyItems = nil
+	model.PolicyType = types.Int64Value(ranger.PolicyTypeDataMask)
+
+	diags = validatePolicyPlan(context.Background(), testPolicyPlan(t, model), serviceDef)
+	if diags.ErrorsCount() != 1 || diags[0].Summary() != "Unsupported Policy Type" {
+		t.Errorf("expected data masking to be unsupported, got: %v", diags)
+	}
+}
@@ -0,0 +1,316 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-scaffolding-framework/internal/ranger"
+)
+
+// RangerPolicyValidityScheduleModel represents a validity schedule of a Ranger policy.
+type RangerPolicyValidityScheduleModel struct {
+	StartTime   types.String                    `tfsdk:"start_time"`
+	EndTime     types.String                    `tfsdk:"end_time"`
+	TimeZone    types.String                    `tfsdk:"time_zone"`
+	Recurrences []RangerValidityRecurrenceModel `tfsdk:"recurrence"`
+}
+
+// RangerValidityRecurrenceModel represents a recurring window within a validity schedule.
+type RangerValidityRecurrenceModel struct {
+	Schedule RangerRecurrenceScheduleModel `tfsdk:"schedule"`
+	Interval RangerValidityIntervalModel   `tfsdk:"interval"`
+}
+
+// RangerRecurrenceScheduleModel holds the cron-like fields of a recurrence.
+type RangerRecurrenceScheduleModel struct {
+	Minute     types.String `tfsdk:"minute"`
+	Hour       types.String `tfsdk:"hour"`
+	DayOfMonth types.String `tfsdk:"day_of_month"`
+	DayOfWeek  types.String `tfsdk:"day_of_week"`
+	Month      types.String `tfsdk:"month"`
+	Year       types.String `tfsdk:"year"`
+}
+
+// RangerValidityIntervalModel is the length of a recurrence window.
+type RangerValidityIntervalModel struct {
+	Days    types.Int64 `tfsdk:"days"`
+	Hours   types.Int64 `tfsdk:"hours"`
+	Minutes types.Int64 `tfsdk:"minutes"`
+}
+
+// validityScheduleAttribute returns the schema of the validity_schedule attribute.
+func validityScheduleAttribute() schema.ListNestedAttribute {
+	cronField := func(description string) schema.StringAttribute {
+		return schema.StringAttribute{
+			MarkdownDescription: description + " (`*` by default)",
+			Optional:            true,
+			Computed:            true,
+			Default:             stringdefault.StaticString("*"),
+		}
+	}
+	intervalField := func(description string) schema.Int64Attribute {
+		return schema.Int64Attribute{
+			MarkdownDescription: description + " (`0` by default)",
+			Optional:            true,
+			Computed:            true,
+			Default:             int64default.StaticInt64(0),
+		}
+	}
+
+	return schema.ListNestedAttribute{
+		MarkdownDescription: "Limits the period during which the policy is enforced. Outside every schedule the policy is ignored, which makes it suitable for temporary grants",
+		Optional:            true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"start_time": schema.StringAttribute{
+					MarkdownDescription: "When the policy starts being enforced, either in RFC3339 (`2025-01-31T09:00:00Z`) or Ranger format (`2025/01/31 09:00:00`). Ranger-format times are interpreted in `time_zone`",
+					Optional:            true,
+				},
+				"end_time": schema.StringAttribute{
+					MarkdownDescription: "When the policy stops being enforced, in the same formats as `start_time`",
+					Optional:            true,
+				},
+				"time_zone": schema.StringAttribute{
+					MarkdownDescription: "IANA time zone of the schedule (e.g., `UTC`, `Europe/Berlin`). RFC3339 times are converted to this zone, or to `UTC` when it is not set",
+					Optional:            true,
+				},
+				"recurrence": schema.ListNestedAttribute{
+					MarkdownDescription: "Recurring windows within the schedule during which the policy is enforced",
+					Optional:            true,
+					NestedObject: schema.NestedAttributeObject{
+						Attributes: map[string]schema.Attribute{
+							"schedule": schema.SingleNestedAttribute{
+								MarkdownDescription: "Cron-like description of when each window opens",
+								Required:            true,
+								Attributes: map[string]schema.Attribute{
+									"minute":       cronField("Minutes of the hour"),
+									"hour":         cronField("Hours of the day"),
+									"day_of_month": cronField("Days of the month"),
+									"day_of_week":  cronField("Days of the week, `1` (Sunday) to `7` (Saturday)"),
+									"month":        cronField("Months of the year, `0` (January) to `11` (December)"),
+									"year":         cronField("Years"),
+								},
+							},
+							"interval": schema.SingleNestedAttribute{
+								MarkdownDescription: "How long each window stays open",
+								Required:            true,
+								Attributes: map[string]schema.Attribute{
+									"days":    intervalField("Days"),
+									"hours":   intervalField("Hours"),
+									"minutes": intervalField("Minutes"),
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// parseScheduleTime parses a timestamp in RFC3339 or Ranger format. It
+// reports whether the value was an RFC3339 timestamp.
+func parseScheduleTime(value string, loc *time.Location) (time.Time, bool, error) {
+	if t, err := time.ParseInLocation(ranger.TimeFormat, value, loc); err == nil {
+		return t, false, nil
+	}
+
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return time.Time{}, false, fmt.Errorf("%q is neither an RFC3339 timestamp nor in Ranger format (YYYY/MM/DD hh:mm:ss)", value)
+	}
+	return t.In(loc), true, nil
+}
+
+// scheduleLocation returns the location of a schedule time zone, UTC when it is not set.
+func scheduleLocation(timeZone types.String) (*time.Location, error) {
+	if timeZone.IsNull() || timeZone.IsUnknown() || timeZone.ValueString() == "" {
+		return time.UTC, nil
+	}
+	return time.LoadLocation(timeZone.ValueString())
+}
+
+// convertValidityScheduleModels converts Terraform validity schedule models to Ranger validity schedules.
+func convertValidityScheduleModels(scheduleModels []RangerPolicyValidityScheduleModel) ([]ranger.ValiditySchedule, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var schedules []ranger.ValiditySchedule
+
+	for i, scheduleModel := range scheduleModels {
+		schedulePath := path.Root("validity_schedule").AtListIndex(i)
+
+		loc, err := scheduleLocation(scheduleModel.TimeZone)
+		if err != nil {
+			diags.AddAttributeError(schedulePath.AtName("time_zone"), "Invalid Time Zone", err.Error())
+			continue
+		}
+
+		schedule := ranger.ValiditySchedule{
+			TimeZone: scheduleModel.TimeZone.ValueString(),
+		}
+
+		usesRFC3339 := false
+		for _, field := range []struct {
+			name  string
+			value types.String
+			out   *string
+		}{
+			{"start_time", scheduleModel.StartTime, &schedule.StartTime},
+			{"end_time", scheduleModel.EndTime, &schedule.EndTime},
+		} {
+			if field.value.IsNull() {
+				continue
+			}
+
+			t, isRFC3339, err := parseScheduleTime(field.value.ValueString(), loc)
+			if err != nil {
+				diags.AddAttributeError(schedulePath.AtName(field.name), "Invalid Validity Schedule Time", err.Error())
+				continue
+			}
+			usesRFC3339 = usesRFC3339 || isRFC3339
+			*field.out = t.Format(ranger.TimeFormat)
+		}
+
+		// Pin RFC3339 times to UTC so Ranger does not read them in its own zone
+		if usesRFC3339 && schedule.TimeZone == "" {
+			schedule.TimeZone = "UTC"
+		}
+
+		for _, recurrence := range scheduleModel.Recurrences {
+			schedule.Recurrences = append(schedule.Recurrences, ranger.ValidityRecurrence{
+				Schedule: ranger.RecurrenceSchedule{
+					Minute:     recurrence.Schedule.Minute.ValueString(),
+					Hour:       recurrence.Schedule.Hour.ValueString(),
+					DayOfMonth: recurrence.Schedule.DayOfMonth.ValueString(),
+					DayOfWeek:  recurrence.Schedule.DayOfWeek.ValueString(),
+					Month:      recurrence.Schedule.Month.ValueString(),
+					Year:       recurrence.Schedule.Year.ValueString(),
+				},
+				Interval: ranger.ValidityInterval{
+					Days:    recurrence.Interval.Days.ValueInt64(),
+					Hours:   recurrence.Interval.Hours.ValueInt64(),
+					Minutes: recurrence.Interval.Minutes.ValueInt64(),
+				},
+			})
+		}
+
+		schedules = append(schedules, schedule)
+	}
+
+	return schedules, diags
+}
+
+// convertValiditySchedules converts Ranger validity schedules to Terraform validity schedule models.
+func convertValiditySchedules(schedules []ranger.ValiditySchedule) []RangerPolicyValidityScheduleModel {
+	var scheduleModels []RangerPolicyValidityScheduleModel
+
+	for _, schedule := range schedules {
+		scheduleModel := RangerPolicyValidityScheduleModel{
+			StartTime: stringValueOrNull(schedule.StartTime),
+			EndTime:   stringValueOrNull(schedule.EndTime),
+			TimeZone:  stringValueOrNull(schedule.TimeZone),
+		}
+
+		for _, recurrence := range schedule.Recurrences {
+			scheduleModel.Recurrences = append(scheduleModel.Recurrences, RangerValidityRecurrenceModel{
+				Schedule: RangerRecurrenceScheduleModel{
+					Minute:     types.StringValue(recurrence.Schedule.Minute),
+					Hour:       types.StringValue(recurrence.Schedule.Hour),
+					DayOfMonth: types.StringValue(recurrence.Schedule.DayOfMonth),
+					DayOfWeek:  types.StringValue(recurrence.Schedule.DayOfWeek),
+					Month:      types.StringValue(recurrence.Schedule.Month),
+					Year:       types.StringValue(recurrence.Schedule.Year),
+				},
+				Interval: RangerValidityIntervalModel{
+					Days:    types.Int64Value(recurrence.Interval.Days),
+					Hours:   types.Int64Value(recurrence.Interval.Hours),
+					Minutes: types.Int64Value(recurrence.Interval.Minutes),
+				},
+			})
+		}
+
+		scheduleModels = append(scheduleModels, scheduleModel)
+	}
+
+	return scheduleModels
+}
+
+// reconcileValiditySchedules keeps the configured spelling of schedule times
+// and time zones when Ranger returns an equivalent value in its own format.
+func reconcileValiditySchedules(prior, current []RangerPolicyValidityScheduleModel) []RangerPolicyValidityScheduleModel {
+	if len(prior) != len(current) {
+		return current
+	}
+
+	priorSchedules, diags := convertValidityScheduleModels(prior)
+	if diags.HasError() {
+		return current
+	}
+
+	for i := range current {
+		expected := priorSchedules[i]
+
+		if current[i].StartTime.ValueString() == expected.StartTime {
+			current[i].StartTime = prior[i].StartTime
+		}
+		if current[i].EndTime.ValueString() == expected.EndTime {
+			current[i].EndTime = prior[i].EndTime
+		}
+		if current[i].TimeZone.ValueString() == expected.TimeZone {
+			current[i].TimeZone = prior[i].TimeZone
+		}
+	}
+
+	return current
+}
+
+// validateValiditySchedules checks time zones and timestamps of the configured
+// validity schedules, skipping values that are not yet known.
+func validateValiditySchedules(scheduleModels []RangerPolicyValidityScheduleModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	for i, scheduleModel := range scheduleModels {
+		schedulePath := path.Root("validity_schedule").AtListIndex(i)
+		if scheduleModel.TimeZone.IsUnknown() {
+			continue
+		}
+
+		loc, err := scheduleLocation(scheduleModel.TimeZone)
+		if err != nil {
+			diags.AddAttributeError(schedulePath.AtName("time_zone"), "Invalid Time Zone", err.Error())
+			continue
+		}
+
+		var start, end time.Time
+		if !scheduleModel.StartTime.IsNull() && !scheduleModel.StartTime.IsUnknown() {
+			start, _, err = parseScheduleTime(scheduleModel.StartTime.ValueString(), loc)
+			if err != nil {
+				diags.AddAttributeError(schedulePath.AtName("start_time"), "Invalid Validity Schedule Time", err.Error())
+			}
+		}
+		if !scheduleModel.EndTime.IsNull() && !scheduleModel.EndTime.IsUnknown() {
+			end, _, err = parseScheduleTime(scheduleModel.EndTime.ValueString(), loc)
+			if err != nil {
+				diags.AddAttributeError(schedulePath.AtName("end_time"), "Invalid Validity Schedule Time", err.Error())
+			}
+		}
+
+		if !start.IsZero() && !end.IsZero() && !end.After(start) {
+			diags.AddAttributeError(
+				schedulePath.AtName("end_time"),
+				"Invalid Validity Schedule",
+				fmt.Sprintf("end_time %s must be after start_time %s.", scheduleModel.EndTime.ValueString(), scheduleModel.StartTime.ValueString()),
+			)
+		}
+	}
+
+	return diags
+}
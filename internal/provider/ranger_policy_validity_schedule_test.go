@@ -0,0 +1,87 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestConvertValidityScheduleModels(t *testing.T) {
+	schedules, diags := convertValidityScheduleModels([]RangerPolicyValidityScheduleModel{
+		{
+			StartTime: types.StringValue("2025-03-01T08:00:00Z"),
+			EndTime:   types.StringValue("2025-03-02T08:00:00+01:00"),
+			TimeZone:  types.StringNull(),
+		},
+		{
+			StartTime: types.StringValue("2025/03/01 09:00:00"),
+			EndTime:   types.StringNull(),
+			TimeZone:  types.StringValue("Europe/Berlin"),
+		},
+	})
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	if got := schedules[0]; got.StartTime != "2025/03/01 08:00:00" || got.EndTime != "2025/03/02 07:00:00" || got.TimeZone != "UTC" {
+		t.Errorf("unexpected RFC3339 conversion: %+v", got)
+	}
+	if got := schedules[1]; got.StartTime != "2025/03/01 09:00:00" || got.EndTime != "" || got.TimeZone != "Europe/Berlin" {
+		t.Errorf("unexpected Ranger format conversion: %+v", got)
+	}
+}
+
+func TestReconcileValiditySchedules(t *testing.T) {
+	prior := []RangerPolicyValidityScheduleModel{
+		{
+			StartTime: types.StringValue("2025-03-01T08:00:00Z"),
+			EndTime:   types.StringNull(),
+			TimeZone:  types.StringNull(),
+		},
+	}
+	current := []RangerPolicyValidityScheduleModel{
+		{
+			StartTime: types.StringValue("2025/03/01 08:00:00"),
+			EndTime:   types.StringNull(),
+			TimeZone:  types.StringValue("UTC"),
+		},
+	}
+
+	got := reconcileValiditySchedules(prior, current)
+	if got[0].StartTime.ValueString() != "2025-03-01T08:00:00Z" || !got[0].TimeZone.IsNull() {
+		t.Errorf("equivalent values were not preserved: %+v", got[0])
+	}
+
+	current[0].StartTime = types.StringValue("2025/03/05 08:00:00")
+	got = reconcileValiditySchedules(prior, current)
+	if got[0].StartTime.ValueString() != "2025/03/05 08:00:00" {
+		t.Errorf("drift was hidden: %+v", got[0])
+	}
+}
+
+func TestValidateValiditySchedules(t *testing.T) {
+	diags := validateValiditySchedules([]RangerPolicyValidityScheduleModel{
+		{
+			StartTime: types.StringValue("2025/03/02 00:00:00"),
+			EndTime:   types.StringValue("2025/03/01 00:00:00"),
+			TimeZone:  types.StringValue("Mars/Olympus_Mons"),
+		},
+		{
+			StartTime: types.StringValue("2025/03/02 00:00:00"),
+			EndTime:   types.StringValue("2025/03/01 00:00:00"),
+			TimeZone:  types.StringNull(),
+		},
+		{
+			StartTime: types.StringValue("next tuesday"),
+			EndTime:   types.StringUnknown(),
+			TimeZone:  types.StringNull(),
+		},
+	})
+
+	if diags.ErrorsCount() != 3 {
+		t.Errorf("expected 3 errors (time zone, end before start, bad format), got %d: %v", diags.ErrorsCount(), diags)
+	}
+}
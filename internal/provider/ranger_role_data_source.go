@@ -0,0 +1,158 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-scaffolding-framework/internal/ranger"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &RangerRoleDataSource{}
+
+// NewRangerRoleDataSource creates a new data source for Ranger roles.
+func NewRangerRoleDataSource() datasource.DataSource {
+	return &RangerRoleDataSource{}
+}
+
+// RangerRoleDataSource defines the data source implementation.
+type RangerRoleDataSource struct {
+	client *RangerClient
+}
+
+// RangerRoleDataSourceModel describes the data source data model.
+type RangerRoleDataSourceModel struct {
+	ID          types.String            `tfsdk:"id"`
+	Name        types.String            `tfsdk:"name"`
+	Description types.String            `tfsdk:"description"`
+	Users       []RangerRoleMemberModel `tfsdk:"users"`
+	Groups      []RangerRoleMemberModel `tfsdk:"groups"`
+	Roles       []RangerRoleMemberModel `tfsdk:"roles"`
+	Options     map[string]types.String `tfsdk:"options"`
+}
+
+// Metadata returns the data source type name.
+func (d *RangerRoleDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_role"
+}
+
+// Schema defines the schema for the data source.
+func (d *RangerRoleDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attributes := roleDataSourceAttributes()
+	attributes["name"] = schema.StringAttribute{
+		MarkdownDescription: "The name of the role",
+		Required:            true,
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Retrieve an existing Apache Ranger role and its direct members",
+		Attributes:          attributes,
+	}
+}
+
+// roleDataSourceAttributes returns the computed attributes describing a role,
+// shared by the role and roles data sources.
+func roleDataSourceAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			MarkdownDescription: "The internal ID of the role in Apache Ranger",
+			Computed:            true,
+		},
+		"name": schema.StringAttribute{
+			MarkdownDescription: "The name of the role",
+			Computed:            true,
+		},
+		"description": schema.StringAttribute{
+			MarkdownDescription: "A human-readable description of the role",
+			Computed:            true,
+		},
+		"users":  roleMemberDataSourceAttribute("users"),
+		"groups": roleMemberDataSourceAttribute("groups"),
+		"roles":  roleMemberDataSourceAttribute("roles"),
+		"options": schema.MapAttribute{
+			ElementType:         types.StringType,
+			MarkdownDescription: "Options of the role",
+			Computed:            true,
+		},
+	}
+}
+
+// roleMemberDataSourceAttribute returns the computed attribute of the users,
+// groups or roles of a role.
+func roleMemberDataSourceAttribute(members string) schema.SetNestedAttribute {
+	return schema.SetNestedAttribute{
+		MarkdownDescription: fmt.Sprintf("The %s of the role", members),
+		Computed:            true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"name": schema.StringAttribute{
+					MarkdownDescription: "The name of the member",
+					Computed:            true,
+				},
+				"is_admin": schema.BoolAttribute{
+					MarkdownDescription: "Whether the member may manage the membership of the role",
+					Computed:            true,
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *RangerRoleDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*RangerClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *RangerClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+// Read reads the data source.
+func (d *RangerRoleDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data RangerRoleDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading Ranger role", map[string]interface{}{
+		"name": data.Name.ValueString(),
+	})
+
+	role, err := d.client.GetRoleByName(ctx, data.Name.ValueString())
+	if ranger.IsNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Ranger Role Not Found",
+			fmt.Sprintf("No role found with name %q", data.Name.ValueString()),
+		)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Ranger Role",
+			fmt.Sprintf("Could not read role %q: %s", data.Name.ValueString(), err),
+		)
+		return
+	}
+
+	data = RangerRoleDataSourceModel(convertRoleToModel(*role, RangerRoleResourceModel{}))
+
+	// Set the state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
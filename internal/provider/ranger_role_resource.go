@@ -0,0 +1,380 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-scaffolding-framework/internal/ranger"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &rangerRoleResource{}
+	_ resource.ResourceWithImportState = &rangerRoleResource{}
+)
+
+// NewRangerRoleResource is a helper function to simplify the provider implementation.
+func NewRangerRoleResource() resource.Resource {
+	return &rangerRoleResource{}
+}
+
+// rangerRoleResource is the resource implementation.
+type rangerRoleResource struct {
+	client *RangerClient
+}
+
+// RangerRoleResourceModel maps the resource schema to Go objects.
+type RangerRoleResourceModel struct {
+	ID          types.String            `tfsdk:"id"`
+	Name        types.String            `tfsdk:"name"`
+	Description types.String            `tfsdk:"description"`
+	Users       []RangerRoleMemberModel `tfsdk:"users"`
+	Groups      []RangerRoleMemberModel `tfsdk:"groups"`
+	Roles       []RangerRoleMemberModel `tfsdk:"roles"`
+	Options     map[string]types.String `tfsdk:"options"`
+}
+
+// RangerRoleMemberModel describes a user, group or role of a role.
+type RangerRoleMemberModel struct {
+	Name    types.String `tfsdk:"name"`
+	IsAdmin types.Bool   `tfsdk:"is_admin"`
+}
+
+// Metadata returns the resource type name.
+func (r *rangerRoleResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_role"
+}
+
+// Schema defines the schema for the resource.
+func (r *rangerRoleResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Apache Ranger role resource. Policy items grant access to roles through `roles`",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The internal ID of the role in Apache Ranger",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the role. Policies refer to the role by this name",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "A human-readable description of the role",
+				Optional:            true,
+			},
+			"users":  roleMemberAttribute("users"),
+			"groups": roleMemberAttribute("groups"),
+			"roles":  roleMemberAttribute("roles"),
+			"options": schema.MapAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "Options of the role",
+				Optional:            true,
+			},
+		},
+	}
+}
+
+// roleMemberAttribute returns the schema attribute of the users, groups or
+// roles of a role.
+func roleMemberAttribute(members string) schema.SetNestedAttribute {
+	return schema.SetNestedAttribute{
+		MarkdownDescription: fmt.Sprintf("The %s of the role", members),
+		Optional:            true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"name": schema.StringAttribute{
+					MarkdownDescription: "The name of the member",
+					Required:            true,
+				},
+				"is_admin": schema.BoolAttribute{
+					MarkdownDescription: "Whether the member may manage the membership of the role",
+					Optional:            true,
+					Computed:            true,
+					Default:             booldefault.StaticBool(false),
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *rangerRoleResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*RangerClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *RangerClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// Create creates a new Ranger role.
+func (r *rangerRoleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan RangerRoleResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	role := convertModelToRole(plan)
+
+	createdRole, err := r.client.CreateRole(ctx, &role)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating Ranger Role",
+			fmt.Sprintf("Could not create role %q: %s", role.Name, err),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(fmt.Sprintf("%d", createdRole.ID))
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "Created Ranger role", map[string]interface{}{
+		"id":   createdRole.ID,
+		"name": createdRole.Name,
+	})
+}
+
+// Read reads the Ranger role from the API.
+func (r *rangerRoleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state RangerRoleResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := parseInt64(state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Ranger Role",
+			fmt.Sprintf("Could not parse role ID: %s", err),
+		)
+		return
+	}
+
+	role, err := r.client.GetRole(ctx, id)
+	if ranger.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Ranger Role",
+			fmt.Sprintf("Could not read role ID %d: %s", id, err),
+		)
+		return
+	}
+
+	model := convertRoleToModel(*role, state)
+
+	diags = resp.State.Set(ctx, model)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update updates an existing Ranger role.
+func (r *rangerRoleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan RangerRoleResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := parseInt64(plan.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Ranger Role",
+			fmt.Sprintf("Could not parse role ID: %s", err),
+		)
+		return
+	}
+
+	role := convertModelToRole(plan)
+	role.ID = id
+
+	updatedRole, err := r.client.UpdateRole(ctx, id, &role)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Ranger Role",
+			fmt.Sprintf("Could not update role ID %d: %s", id, err),
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "Updated Ranger role", map[string]interface{}{
+		"id":   updatedRole.ID,
+		"name": updatedRole.Name,
+	})
+}
+
+// Delete deletes a Ranger role.
+func (r *rangerRoleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state RangerRoleResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := parseInt64(state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting Ranger Role",
+			fmt.Sprintf("Could not parse role ID: %s", err),
+		)
+		return
+	}
+
+	err = r.client.DeleteRole(ctx, id)
+	if err != nil && !ranger.IsNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Error Deleting Ranger Role",
+			fmt.Sprintf("Could not delete role ID %d: %s", id, err),
+		)
+		return
+	}
+
+	tflog.Info(ctx, "Deleted Ranger role", map[string]interface{}{
+		"id": id,
+	})
+}
+
+// ImportState imports a Ranger role by name.
+func (r *rangerRoleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	role, err := r.client.GetRoleByName(ctx, req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Importing Ranger Role",
+			fmt.Sprintf("Could not find role %q: %s", req.ID, err),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), fmt.Sprintf("%d", role.ID))...)
+}
+
+// convertModelToRole converts a Terraform model to a Ranger role.
+func convertModelToRole(model RangerRoleResourceModel) ranger.Role {
+	role := ranger.Role{
+		Name:        model.Name.ValueString(),
+		Description: model.Description.ValueString(),
+		IsEnabled:   true,
+		Users:       convertRoleMemberModels(model.Users),
+		Groups:      convertRoleMemberModels(model.Groups),
+		Roles:       convertRoleMemberModels(model.Roles),
+	}
+
+	if len(model.Options) > 0 {
+		role.Options = make(map[string]interface{}, len(model.Options))
+		for key, value := range model.Options {
+			role.Options[key] = value.ValueString()
+		}
+	}
+
+	return role
+}
+
+// convertRoleToModel converts a Ranger role to a Terraform model. Ranger
+// returns no members or options alike whether they were configured empty or
+// not at all, so they are only empty rather than null when the prior state
+// had them empty.
+func convertRoleToModel(role ranger.Role, prior RangerRoleResourceModel) RangerRoleResourceModel {
+	model := RangerRoleResourceModel{
+		ID:          types.StringValue(fmt.Sprintf("%d", role.ID)),
+		Name:        types.StringValue(role.Name),
+		Description: stringValueOrNull(role.Description),
+		Users:       convertRoleMembers(role.Users),
+		Groups:      convertRoleMembers(role.Groups),
+		Roles:       convertRoleMembers(role.Roles),
+	}
+
+	for _, members := range []struct {
+		model *[]RangerRoleMemberModel
+		prior []RangerRoleMemberModel
+	}{
+		{&model.Users, prior.Users},
+		{&model.Groups, prior.Groups},
+		{&model.Roles, prior.Roles},
+	} {
+		if *members.model == nil && members.prior != nil {
+			*members.model = []RangerRoleMemberModel{}
+		}
+	}
+
+	if len(role.Options) > 0 || prior.Options != nil {
+		model.Options = make(map[string]types.String, len(role.Options))
+		for key, value := range role.Options {
+			model.Options[key] = types.StringValue(optionString(value))
+		}
+	}
+
+	return model
+}
+
+// convertRoleMemberModels converts Terraform role member models to Ranger
+// role members. Ranger expects an empty list rather than null.
+func convertRoleMemberModels(models []RangerRoleMemberModel) []ranger.RoleMember {
+	members := make([]ranger.RoleMember, 0, len(models))
+	for _, model := range models {
+		members = append(members, ranger.RoleMember{
+			Name:    model.Name.ValueString(),
+			IsAdmin: model.IsAdmin.ValueBool(),
+		})
+	}
+	return members
+}
+
+// convertRoleMembers converts Ranger role members to Terraform models,
+// returning nil when there are none so the attribute is null rather than empty.
+func convertRoleMembers(members []ranger.RoleMember) []RangerRoleMemberModel {
+	if len(members) == 0 {
+		return nil
+	}
+
+	models := make([]RangerRoleMemberModel, 0, len(members))
+	for _, member := range members {
+		models = append(models, RangerRoleMemberModel{
+			Name:    types.StringValue(member.Name),
+			IsAdmin: types.BoolValue(member.IsAdmin),
+		})
+	}
+	return models
+}
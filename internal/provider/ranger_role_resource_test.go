@@ -0,0 +1,60 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-scaffolding-framework/internal/ranger"
+)
+
+func TestRoleModelRoundTrip(t *testing.T) {
+	model := RangerRoleResourceModel{
+		ID:          types.StringValue("9"),
+		Name:        types.StringValue("data_stewards"),
+		Description: types.StringNull(),
+		Users: []RangerRoleMemberModel{
+			{Name: types.StringValue("alice"), IsAdmin: types.BoolValue(true)},
+			{Name: types.StringValue("bob"), IsAdmin: types.BoolValue(false)},
+		},
+		Groups: []RangerRoleMemberModel{
+			{Name: types.StringValue("stewards"), IsAdmin: types.BoolValue(false)},
+		},
+		Options: map[string]types.String{"source": types.StringValue("terraform")},
+	}
+
+	role := convertModelToRole(model)
+	if role.Roles == nil || len(role.Roles) != 0 {
+		t.Errorf("expected no nested roles to be sent as an empty list, got %v", role.Roles)
+	}
+	if !role.Users[0].IsAdmin || role.Options["source"] != "terraform" {
+		t.Errorf("unexpected role: %+v", role)
+	}
+
+	role.ID = 9
+	converted := convertRoleToModel(role, model)
+	if converted.Roles != nil {
+		t.Errorf("expected no nested roles to be null, got %v", converted.Roles)
+	}
+	if len(converted.Users) != 2 || !converted.Users[0].IsAdmin.ValueBool() || converted.Options["source"].ValueString() != "terraform" {
+		t.Errorf("unexpected model: %+v", converted)
+	}
+
+	// Ranger allows options of any JSON type
+	converted = convertRoleToModel(ranger.Role{Name: "r", Options: map[string]interface{}{"priority": float64(2)}}, RangerRoleResourceModel{})
+	if converted.Options["priority"].ValueString() != "2" {
+		t.Errorf("unexpected options: %v", converted.Options)
+	}
+
+	// Empty options and members stay empty when configured so
+	empty := RangerRoleResourceModel{Roles: []RangerRoleMemberModel{}, Options: map[string]types.String{}}
+	converted = convertRoleToModel(ranger.Role{Name: "r"}, empty)
+	if converted.Options == nil || len(converted.Options) != 0 || converted.Roles == nil || len(converted.Roles) != 0 {
+		t.Errorf("expected empty options and roles, got %v, %v", converted.Options, converted.Roles)
+	}
+	if converted.Users != nil {
+		t.Errorf("expected unset users to stay null, got %v", converted.Users)
+	}
+}
@@ -0,0 +1,156 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-scaffolding-framework/internal/ranger"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &RangerRolesDataSource{}
+
+// NewRangerRolesDataSource creates a new data source listing Ranger roles.
+func NewRangerRolesDataSource() datasource.DataSource {
+	return &RangerRolesDataSource{}
+}
+
+// RangerRolesDataSource defines the data source implementation.
+type RangerRolesDataSource struct {
+	client *RangerClient
+}
+
+// RangerRolesDataSourceModel describes the data source data model.
+type RangerRolesDataSourceModel struct {
+	User  types.String                `tfsdk:"user"`
+	Group types.String                `tfsdk:"group"`
+	Names []types.String              `tfsdk:"names"`
+	Roles []RangerRoleDataSourceModel `tfsdk:"roles"`
+}
+
+// Metadata returns the data source type name.
+func (d *RangerRolesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_roles"
+}
+
+// Schema defines the schema for the data source.
+func (d *RangerRolesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "List the Apache Ranger roles, optionally only those of a user or group. Filters left unset match every role",
+		Attributes: map[string]schema.Attribute{
+			"user": schema.StringAttribute{
+				MarkdownDescription: "Only list the roles Ranger resolves for this user",
+				Optional:            true,
+			},
+			"group": schema.StringAttribute{
+				MarkdownDescription: "Only list the roles this group is a direct member of",
+				Optional:            true,
+			},
+			"names": schema.ListAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "The names of the matching roles, sorted",
+				Computed:            true,
+			},
+			"roles": schema.ListNestedAttribute{
+				MarkdownDescription: "The matching roles with their members, sorted by name",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: roleDataSourceAttributes(),
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *RangerRolesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*RangerClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *RangerClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+// Read reads the data source.
+func (d *RangerRolesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data RangerRolesDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Listing Ranger roles", map[string]interface{}{
+		"user":  data.User.ValueString(),
+		"group": data.Group.ValueString(),
+	})
+
+	var names []string
+	var err error
+	if !data.User.IsNull() {
+		names, err = d.client.GetUserRoles(ctx, data.User.ValueString())
+	} else {
+		names, err = d.client.ListRoleNames(ctx)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Listing Ranger Roles",
+			fmt.Sprintf("Could not list roles: %s", err),
+		)
+		return
+	}
+	sort.Strings(names)
+
+	data.Names = []types.String{}
+	data.Roles = []RangerRoleDataSourceModel{}
+	for _, name := range names {
+		role, err := d.client.GetRoleByName(ctx, name)
+		if ranger.IsNotFound(err) {
+			// Deleted since it was listed
+			continue
+		}
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Reading Ranger Role",
+				fmt.Sprintf("Could not read role %q: %s", name, err),
+			)
+			return
+		}
+
+		if !data.Group.IsNull() && !hasRoleMember(role.Groups, data.Group.ValueString()) {
+			continue
+		}
+
+		data.Names = append(data.Names, types.StringValue(role.Name))
+		data.Roles = append(data.Roles, RangerRoleDataSourceModel(convertRoleToModel(*role, RangerRoleResourceModel{})))
+	}
+
+	// Set the state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// hasRoleMember reports whether members contains a member with the given name.
+func hasRoleMember(members []ranger.RoleMember, name string) bool {
+	for _, member := range members {
+		if member.Name == name {
+			return true
+		}
+	}
+	return false
+}
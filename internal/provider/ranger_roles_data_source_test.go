@@ -0,0 +1,70 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-provider-scaffolding-framework/internal/ranger"
+)
+
+func TestRolesDataSourceRead(t *testing.T) {
+	ctx := context.Background()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/service/public/v2/api/roles/user/alice":
+			_, _ = w.Write([]byte(`["stewards","auditors","deleted"]`))
+		case "/service/public/v2/api/roles/name/stewards":
+			_, _ = w.Write([]byte(`{"id":1,"name":"stewards","users":[{"name":"alice","isAdmin":true}],"groups":[{"name":"finance","isAdmin":false}],"roles":[]}`))
+		case "/service/public/v2/api/roles/name/auditors":
+			_, _ = w.Write([]byte(`{"id":2,"name":"auditors","users":[],"groups":[{"name":"audit","isAdmin":false}],"roles":[]}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	d := &RangerRolesDataSource{client: &RangerClient{Client: ranger.NewClient(ranger.Config{Endpoint: server.URL})}}
+
+	schemaResp := &datasource.SchemaResponse{}
+	d.Schema(ctx, datasource.SchemaRequest{}, schemaResp)
+	objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+
+	config := map[string]tftypes.Value{}
+	for name, attrType := range objectType.AttributeTypes {
+		config[name] = tftypes.NewValue(attrType, nil)
+	}
+	config["user"] = tftypes.NewValue(tftypes.String, "alice")
+	config["group"] = tftypes.NewValue(tftypes.String, "finance")
+
+	req := datasource.ReadRequest{
+		Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, config)},
+	}
+	resp := &datasource.ReadResponse{
+		State: tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, nil)},
+	}
+	d.Read(ctx, req, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected errors: %v", resp.Diagnostics)
+	}
+
+	var data RangerRolesDataSourceModel
+	resp.Diagnostics.Append(resp.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("could not read state: %v", resp.Diagnostics)
+	}
+	if len(data.Names) != 1 || data.Names[0].ValueString() != "stewards" {
+		t.Errorf("expected only the role of the group, got %v", data.Names)
+	}
+	if len(data.Roles) != 1 || !data.Roles[0].Users[0].IsAdmin.ValueBool() || data.Roles[0].Roles != nil {
+		t.Errorf("unexpected roles: %+v", data.Roles)
+	}
+}
@@ -0,0 +1,388 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-scaffolding-framework/internal/ranger"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &rangerSecurityZoneResource{}
+	_ resource.ResourceWithImportState = &rangerSecurityZoneResource{}
+)
+
+// NewRangerSecurityZoneResource is a helper function to simplify the provider implementation.
+func NewRangerSecurityZoneResource() resource.Resource {
+	return &rangerSecurityZoneResource{}
+}
+
+// rangerSecurityZoneResource is the resource implementation.
+type rangerSecurityZoneResource struct {
+	client *RangerClient
+}
+
+// RangerSecurityZoneResourceModel maps the resource schema to Go objects.
+type RangerSecurityZoneResourceModel struct {
+	ID              types.String                              `tfsdk:"id"`
+	Name            types.String                              `tfsdk:"name"`
+	Description     types.String                              `tfsdk:"description"`
+	Services        map[string]RangerSecurityZoneServiceModel `tfsdk:"services"`
+	TagServices     []types.String                            `tfsdk:"tag_services"`
+	AdminUsers      []types.String                            `tfsdk:"admin_users"`
+	AdminUserGroups []types.String                            `tfsdk:"admin_user_groups"`
+	AdminRoles      []types.String                            `tfsdk:"admin_roles"`
+	AuditUsers      []types.String                            `tfsdk:"audit_users"`
+	AuditUserGroups []types.String                            `tfsdk:"audit_user_groups"`
+	AuditRoles      []types.String                            `tfsdk:"audit_roles"`
+}
+
+// RangerSecurityZoneServiceModel describes the resources of a service that
+// belong to a security zone.
+type RangerSecurityZoneServiceModel struct {
+	Resources []map[string][]types.String `tfsdk:"resources"`
+}
+
+// Metadata returns the resource type name.
+func (r *rangerSecurityZoneResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_security_zone"
+}
+
+// Schema defines the schema for the resource.
+func (r *rangerSecurityZoneResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Apache Ranger security zone resource. A zone carves resources out of services and delegates the administration of their policies. Policies join a zone through their `zone_name`",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The internal ID of the security zone in Apache Ranger",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the security zone. Policies refer to the zone by this name",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "A human-readable description of the security zone",
+				Optional:            true,
+			},
+			"services": schema.MapNestedAttribute{
+				MarkdownDescription: "The resources of each service that belong to the zone, keyed by service name",
+				Optional:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"resources": schema.ListAttribute{
+							ElementType: types.MapType{
+								ElemType: types.ListType{ElemType: types.StringType},
+							},
+							MarkdownDescription: "Resource sets of the service, each mapping resource names (e.g., `database`, `table`) to values. Wildcards are supported",
+							Required:            true,
+						},
+					},
+				},
+			},
+			"tag_services": schema.SetAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "The tag services whose tag-based policies apply to the zone",
+				Optional:            true,
+			},
+			"admin_users": schema.SetAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "Users who administer the policies of the zone",
+				Optional:            true,
+			},
+			"admin_user_groups": schema.SetAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "Groups whose users administer the policies of the zone",
+				Optional:            true,
+			},
+			"admin_roles": schema.SetAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "Roles whose members administer the policies of the zone",
+				Optional:            true,
+			},
+			"audit_users": schema.SetAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "Users who may read the policies and audit logs of the zone",
+				Optional:            true,
+			},
+			"audit_user_groups": schema.SetAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "Groups whose users may read the policies and audit logs of the zone",
+				Optional:            true,
+			},
+			"audit_roles": schema.SetAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "Roles whose members may read the policies and audit logs of the zone",
+				Optional:            true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *rangerSecurityZoneResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*RangerClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *RangerClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// Create creates a new Ranger security zone.
+func (r *rangerSecurityZoneResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan RangerSecurityZoneResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	zone := convertModelToSecurityZone(plan)
+
+	createdZone, err := r.client.CreateSecurityZone(ctx, &zone)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating Ranger Security Zone",
+			fmt.Sprintf("Could not create security zone %q: %s", zone.Name, err),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(fmt.Sprintf("%d", createdZone.ID))
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "Created Ranger security zone", map[string]interface{}{
+		"id":   createdZone.ID,
+		"name": createdZone.Name,
+	})
+}
+
+// Read reads the Ranger security zone from the API.
+func (r *rangerSecurityZoneResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state RangerSecurityZoneResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := parseInt64(state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Ranger Security Zone",
+			fmt.Sprintf("Could not parse security zone ID: %s", err),
+		)
+		return
+	}
+
+	zone, err := r.client.GetSecurityZone(ctx, id)
+	if ranger.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Ranger Security Zone",
+			fmt.Sprintf("Could not read security zone ID %d: %s", id, err),
+		)
+		return
+	}
+
+	model := convertSecurityZoneToModel(*zone)
+
+	diags = resp.State.Set(ctx, model)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update updates an existing Ranger security zone.
+func (r *rangerSecurityZoneResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan RangerSecurityZoneResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := parseInt64(plan.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Ranger Security Zone",
+			fmt.Sprintf("Could not parse security zone ID: %s", err),
+		)
+		return
+	}
+
+	zone := convertModelToSecurityZone(plan)
+	zone.ID = id
+
+	updatedZone, err := r.client.UpdateSecurityZone(ctx, id, &zone)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Ranger Security Zone",
+			fmt.Sprintf("Could not update security zone ID %d: %s", id, err),
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "Updated Ranger security zone", map[string]interface{}{
+		"id":   updatedZone.ID,
+		"name": updatedZone.Name,
+	})
+}
+
+// Delete deletes a Ranger security zone.
+func (r *rangerSecurityZoneResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state RangerSecurityZoneResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := parseInt64(state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting Ranger Security Zone",
+			fmt.Sprintf("Could not parse security zone ID: %s", err),
+		)
+		return
+	}
+
+	err = r.client.DeleteSecurityZone(ctx, id)
+	if err != nil && !ranger.IsNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Error Deleting Ranger Security Zone",
+			fmt.Sprintf("Could not delete security zone ID %d: %s", id, err),
+		)
+		return
+	}
+
+	tflog.Info(ctx, "Deleted Ranger security zone", map[string]interface{}{
+		"id": id,
+	})
+}
+
+// ImportState imports a Ranger security zone by name.
+func (r *rangerSecurityZoneResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	zone, err := r.client.GetSecurityZoneByName(ctx, req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Importing Ranger Security Zone",
+			fmt.Sprintf("Could not find security zone %q: %s", req.ID, err),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), fmt.Sprintf("%d", zone.ID))...)
+}
+
+// convertModelToSecurityZone converts a Terraform model to a Ranger security
+// zone. Ranger expects empty lists rather than null.
+func convertModelToSecurityZone(model RangerSecurityZoneResourceModel) ranger.SecurityZone {
+	zone := ranger.SecurityZone{
+		Name:            model.Name.ValueString(),
+		Description:     model.Description.ValueString(),
+		Services:        make(map[string]ranger.SecurityZoneService, len(model.Services)),
+		TagServices:     valueStrings(model.TagServices),
+		AdminUsers:      valueStrings(model.AdminUsers),
+		AdminUserGroups: valueStrings(model.AdminUserGroups),
+		AdminRoles:      valueStrings(model.AdminRoles),
+		AuditUsers:      valueStrings(model.AuditUsers),
+		AuditUserGroups: valueStrings(model.AuditUserGroups),
+		AuditRoles:      valueStrings(model.AuditRoles),
+	}
+
+	for _, values := range []*[]string{
+		&zone.TagServices,
+		&zone.AdminUsers, &zone.AdminUserGroups, &zone.AdminRoles,
+		&zone.AuditUsers, &zone.AuditUserGroups, &zone.AuditRoles,
+	} {
+		if *values == nil {
+			*values = []string{}
+		}
+	}
+
+	for serviceName, service := range model.Services {
+		resources := make([]map[string][]string, 0, len(service.Resources))
+		for _, resourceSet := range service.Resources {
+			values := make(map[string][]string, len(resourceSet))
+			for resType, resValues := range resourceSet {
+				values[resType] = valueStrings(resValues)
+			}
+			resources = append(resources, values)
+		}
+		zone.Services[serviceName] = ranger.SecurityZoneService{Resources: resources}
+	}
+
+	return zone
+}
+
+// convertSecurityZoneToModel converts a Ranger security zone to a Terraform model.
+func convertSecurityZoneToModel(zone ranger.SecurityZone) RangerSecurityZoneResourceModel {
+	model := RangerSecurityZoneResourceModel{
+		ID:              types.StringValue(fmt.Sprintf("%d", zone.ID)),
+		Name:            types.StringValue(zone.Name),
+		Description:     stringValueOrNull(zone.Description),
+		TagServices:     stringValues(zone.TagServices),
+		AdminUsers:      stringValues(zone.AdminUsers),
+		AdminUserGroups: stringValues(zone.AdminUserGroups),
+		AdminRoles:      stringValues(zone.AdminRoles),
+		AuditUsers:      stringValues(zone.AuditUsers),
+		AuditUserGroups: stringValues(zone.AuditUserGroups),
+		AuditRoles:      stringValues(zone.AuditRoles),
+	}
+
+	if len(zone.Services) > 0 {
+		model.Services = make(map[string]RangerSecurityZoneServiceModel, len(zone.Services))
+		for serviceName, service := range zone.Services {
+			resources := make([]map[string][]types.String, 0, len(service.Resources))
+			for _, resourceSet := range service.Resources {
+				values := make(map[string][]types.String, len(resourceSet))
+				for resType, resValues := range resourceSet {
+					values[resType] = stringValues(resValues)
+				}
+				resources = append(resources, values)
+			}
+			model.Services[serviceName] = RangerSecurityZoneServiceModel{Resources: resources}
+		}
+	}
+
+	return model
+}
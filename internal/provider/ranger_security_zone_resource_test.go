@@ -0,0 +1,46 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-provider-scaffolding-framework/internal/ranger"
+)
+
+func TestSecurityZoneModelRoundTrip(t *testing.T) {
+	ctx := context.Background()
+
+	data, err := os.ReadFile("testdata/security_zone_finance.json")
+	if err != nil {
+		t.Fatalf("could not read recorded security zone: %s", err)
+	}
+
+	var zone ranger.SecurityZone
+	if err := json.Unmarshal(data, &zone); err != nil {
+		t.Fatalf("could not unmarshal recorded security zone: %s", err)
+	}
+
+	model := convertSecurityZoneToModel(zone)
+	if model.AdminRoles != nil || len(model.Services["hive_prod"].Resources[0]["database"]) != 2 {
+		t.Errorf("unexpected model: %+v", model)
+	}
+
+	schemaResp := &resource.SchemaResponse{}
+	(&rangerSecurityZoneResource{}).Schema(ctx, resource.SchemaRequest{}, schemaResp)
+	assertModelFitsSchema(t, schemaResp.Schema, &model)
+
+	// Unset principals are sent as empty lists, as Ranger returns them
+	converted := convertModelToSecurityZone(model)
+	converted.ID = zone.ID
+	got, _ := json.Marshal(converted)
+	want, _ := json.Marshal(zone)
+	if string(got) != string(want) {
+		t.Errorf("security zone differs after round trip:\n got: %s\nwant: %s", got, want)
+	}
+}
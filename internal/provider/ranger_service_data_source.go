@@ -0,0 +1,245 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-scaffolding-framework/internal/ranger"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ datasource.DataSource                   = &RangerServiceDataSource{}
+	_ datasource.DataSourceWithValidateConfig = &RangerServiceDataSource{}
+)
+
+// sensitiveConfigPatterns are the substrings of config keys whose values are
+// treated as secrets.
+var sensitiveConfigPatterns = []string{"password", "secret", "credential", "token", "keytab"}
+
+// NewRangerServiceDataSource creates a new data source for Ranger services.
+func NewRangerServiceDataSource() datasource.DataSource {
+	return &RangerServiceDataSource{}
+}
+
+// RangerServiceDataSource defines the data source implementation.
+type RangerServiceDataSource struct {
+	client *RangerClient
+}
+
+// RangerServiceDataSourceModel describes the data source data model.
+type RangerServiceDataSourceModel struct {
+	ID               types.String            `tfsdk:"id"`
+	Name             types.String            `tfsdk:"name"`
+	Type             types.String            `tfsdk:"type"`
+	Description      types.String            `tfsdk:"description"`
+	IsEnabled        types.Bool              `tfsdk:"is_enabled"`
+	TagService       types.String            `tfsdk:"tag_service"`
+	PolicyVersion    types.Int64             `tfsdk:"policy_version"`
+	PolicyUpdateTime types.String            `tfsdk:"policy_update_time"`
+	Configs          map[string]types.String `tfsdk:"configs"`
+	SensitiveConfigs map[string]types.String `tfsdk:"sensitive_configs"`
+}
+
+// Metadata returns the data source type name.
+func (d *RangerServiceDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_service"
+}
+
+// Schema defines the schema for the data source.
+func (d *RangerServiceDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Retrieve information about an existing Apache Ranger service (repository). Exactly one of `id` or `name` must be set",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The internal ID of the service in Apache Ranger",
+				Optional:            true,
+				Computed:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the Ranger service",
+				Optional:            true,
+				Computed:            true,
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "The service-def type of the service (e.g., `hdfs`, `hive`, `kafka`, `tag`)",
+				Computed:            true,
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "A human-readable description of the service",
+				Computed:            true,
+			},
+			"is_enabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether the service is enabled",
+				Computed:            true,
+			},
+			"tag_service": schema.StringAttribute{
+				MarkdownDescription: "The name of the tag service whose tag-based policies also apply to this service",
+				Computed:            true,
+			},
+			"policy_version": schema.Int64Attribute{
+				MarkdownDescription: "The version of the service's policies, incremented by Ranger on every policy change",
+				Computed:            true,
+			},
+			"policy_update_time": schema.StringAttribute{
+				MarkdownDescription: "When the service's policies last changed, in RFC 3339 format",
+				Computed:            true,
+			},
+			"configs": schema.MapAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "Connection and plugin configuration of the service, except for secrets",
+				Computed:            true,
+			},
+			"sensitive_configs": schema.MapAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "Configuration whose key looks like a secret (`password`, `secret`, `credential`, `token`, `keytab`). Ranger returns password-type values masked",
+				Computed:            true,
+				Sensitive:           true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *RangerServiceDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*RangerClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *RangerClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+// ValidateConfig checks that the service is looked up either by ID or by name.
+func (d *RangerServiceDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var id, name types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("id"), &id)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("name"), &name)...)
+	if resp.Diagnostics.HasError() || id.IsUnknown() || name.IsUnknown() {
+		return
+	}
+
+	if id.IsNull() == name.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("name"),
+			"Invalid Ranger Service Lookup",
+			"Exactly one of id or name must be set.",
+		)
+	}
+}
+
+// Read reads the data source.
+func (d *RangerServiceDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data RangerServiceDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading Ranger service", map[string]interface{}{
+		"name": data.Name.ValueString(),
+		"id":   data.ID.ValueString(),
+	})
+
+	// If an ID is provided, look up the service by ID, otherwise by name
+	var service *ranger.Service
+	var err error
+	var lookup string
+	if !data.ID.IsNull() {
+		lookup = fmt.Sprintf("ID %s", data.ID.ValueString())
+
+		id, parseErr := parseInt64(data.ID.ValueString())
+		if parseErr != nil {
+			resp.Diagnostics.AddError(
+				"Error Reading Ranger Service",
+				fmt.Sprintf("Could not parse service ID: %s", parseErr),
+			)
+			return
+		}
+		service, err = d.client.GetService(ctx, id)
+	} else {
+		lookup = fmt.Sprintf("name %q", data.Name.ValueString())
+		service, err = d.client.GetServiceByName(ctx, data.Name.ValueString())
+	}
+
+	if ranger.IsNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Ranger Service Not Found",
+			fmt.Sprintf("No service found with %s", lookup),
+		)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Ranger Service",
+			fmt.Sprintf("Could not read service with %s: %s", lookup, err),
+		)
+		return
+	}
+
+	data = convertServiceToDataSourceModel(*service)
+
+	// Set the state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// convertServiceToDataSourceModel converts a Ranger service to the data
+// source model, splitting off configs that look like secrets.
+func convertServiceToDataSourceModel(service ranger.Service) RangerServiceDataSourceModel {
+	model := RangerServiceDataSourceModel{
+		ID:               types.StringValue(fmt.Sprintf("%d", service.ID)),
+		Name:             types.StringValue(service.Name),
+		Type:             types.StringValue(service.Type),
+		Description:      stringValueOrNull(service.Description),
+		IsEnabled:        types.BoolValue(service.IsEnabled),
+		TagService:       stringValueOrNull(service.TagService),
+		PolicyVersion:    types.Int64Value(service.PolicyVersion),
+		PolicyUpdateTime: types.StringNull(),
+		Configs:          make(map[string]types.String),
+		SensitiveConfigs: make(map[string]types.String),
+	}
+
+	// Ranger returns the update time in milliseconds since the epoch
+	if service.PolicyUpdateTime != 0 {
+		model.PolicyUpdateTime = types.StringValue(time.UnixMilli(service.PolicyUpdateTime).UTC().Format(time.RFC3339))
+	}
+
+	for key, value := range service.Configs {
+		if isSensitiveConfig(key) {
+			model.SensitiveConfigs[key] = types.StringValue(value)
+		} else {
+			model.Configs[key] = types.StringValue(value)
+		}
+	}
+
+	return model
+}
+
+// isSensitiveConfig reports whether a service config key looks like it holds a secret.
+func isSensitiveConfig(key string) bool {
+	key = strings.ToLower(key)
+	for _, pattern := range sensitiveConfigPatterns {
+		if strings.Contains(key, pattern) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,41 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-scaffolding-framework/internal/ranger"
+)
+
+func TestConvertServiceToDataSourceModel(t *testing.T) {
+	model := convertServiceToDataSourceModel(ranger.Service{
+		ID:               3,
+		Name:             "hive_prod",
+		Type:             "hive",
+		IsEnabled:        true,
+		TagService:       "tags_prod",
+		PolicyVersion:    57,
+		PolicyUpdateTime: 1718000500000,
+		Configs: map[string]string{
+			"username":             "hive",
+			"password":             ranger.MaskedPassword,
+			"jdbc.url":             "jdbc:hive2://hive:10000",
+			"ranger.plugin.secret": "s3cr3t",
+		},
+	})
+
+	if model.PolicyUpdateTime.ValueString() != "2024-06-10T06:21:40Z" || model.PolicyVersion.ValueInt64() != 57 {
+		t.Errorf("unexpected policy version fields: %s %s", model.PolicyVersion, model.PolicyUpdateTime)
+	}
+	if !model.Description.IsNull() || model.TagService.ValueString() != "tags_prod" {
+		t.Errorf("unexpected description or tag service: %s %s", model.Description, model.TagService)
+	}
+	if len(model.Configs) != 2 || model.Configs["jdbc.url"].ValueString() != "jdbc:hive2://hive:10000" {
+		t.Errorf("unexpected configs: %v", model.Configs)
+	}
+	if _, ok := model.SensitiveConfigs["password"]; !ok || len(model.SensitiveConfigs) != 2 {
+		t.Errorf("secrets were not split off: %v", model.SensitiveConfigs)
+	}
+}
@@ -0,0 +1,562 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-scaffolding-framework/internal/ranger"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ datasource.DataSource                   = &RangerServiceDefinitionDataSource{}
+	_ datasource.DataSourceWithValidateConfig = &RangerServiceDefinitionDataSource{}
+)
+
+// NewRangerServiceDefinitionDataSource creates a new data source for Ranger service definitions.
+func NewRangerServiceDefinitionDataSource() datasource.DataSource {
+	return &RangerServiceDefinitionDataSource{}
+}
+
+// RangerServiceDefinitionDataSource defines the data source implementation.
+type RangerServiceDefinitionDataSource struct {
+	client *RangerClient
+}
+
+// RangerServiceDefinitionDataSourceModel describes the data source data model.
+type RangerServiceDefinitionDataSourceModel struct {
+	ID               types.String                    `tfsdk:"id"`
+	Name             types.String                    `tfsdk:"name"`
+	Label            types.String                    `tfsdk:"label"`
+	Description      types.String                    `tfsdk:"description"`
+	Resources        []RangerResourceDefModel        `tfsdk:"resources"`
+	AccessTypes      []RangerAccessTypeDefModel      `tfsdk:"access_types"`
+	PolicyConditions []RangerPolicyConditionDefModel `tfsdk:"policy_conditions"`
+	DataMaskDef      *RangerDataMaskDefModel         `tfsdk:"data_mask_def"`
+	RowFilterDef     *RangerRowFilterDefModel        `tfsdk:"row_filter_def"`
+}
+
+// RangerResourceDefModel describes a resource component of a service definition.
+type RangerResourceDefModel struct {
+	ItemID                 types.Int64             `tfsdk:"item_id"`
+	Name                   types.String            `tfsdk:"name"`
+	Type                   types.String            `tfsdk:"type"`
+	Level                  types.Int64             `tfsdk:"level"`
+	Parent                 types.String            `tfsdk:"parent"`
+	Mandatory              types.Bool              `tfsdk:"mandatory"`
+	LookupSupported        types.Bool              `tfsdk:"lookup_supported"`
+	RecursiveSupported     types.Bool              `tfsdk:"recursive_supported"`
+	ExcludesSupported      types.Bool              `tfsdk:"excludes_supported"`
+	Matcher                types.String            `tfsdk:"matcher"`
+	MatcherOptions         map[string]types.String `tfsdk:"matcher_options"`
+	ValidationRegEx        types.String            `tfsdk:"validation_regex"`
+	ValidationMessage      types.String            `tfsdk:"validation_message"`
+	UIHint                 types.String            `tfsdk:"ui_hint"`
+	Label                  types.String            `tfsdk:"label"`
+	Description            types.String            `tfsdk:"description"`
+	AccessTypeRestrictions []types.String          `tfsdk:"access_type_restrictions"`
+	IsValidLeaf            types.Bool              `tfsdk:"is_valid_leaf"`
+}
+
+// RangerAccessTypeDefModel describes an access type of a service definition.
+type RangerAccessTypeDefModel struct {
+	ItemID        types.Int64    `tfsdk:"item_id"`
+	Name          types.String   `tfsdk:"name"`
+	Label         types.String   `tfsdk:"label"`
+	ImpliedGrants []types.String `tfsdk:"implied_grants"`
+}
+
+// RangerPolicyConditionDefModel describes a policy condition of a service definition.
+type RangerPolicyConditionDefModel struct {
+	ItemID           types.Int64             `tfsdk:"item_id"`
+	Name             types.String            `tfsdk:"name"`
+	Label            types.String            `tfsdk:"label"`
+	Description      types.String            `tfsdk:"description"`
+	Evaluator        types.String            `tfsdk:"evaluator"`
+	EvaluatorOptions map[string]types.String `tfsdk:"evaluator_options"`
+}
+
+// RangerDataMaskDefModel describes the data masking section of a service definition.
+type RangerDataMaskDefModel struct {
+	MaskTypes   []RangerDataMaskTypeDefModel `tfsdk:"mask_types"`
+	AccessTypes []RangerAccessTypeDefModel   `tfsdk:"access_types"`
+	Resources   []RangerResourceDefModel     `tfsdk:"resources"`
+}
+
+// RangerDataMaskTypeDefModel describes a data mask type of a service definition.
+type RangerDataMaskTypeDefModel struct {
+	ItemID          types.Int64             `tfsdk:"item_id"`
+	Name            types.String            `tfsdk:"name"`
+	Label           types.String            `tfsdk:"label"`
+	Description     types.String            `tfsdk:"description"`
+	Transformer     types.String            `tfsdk:"transformer"`
+	DataMaskOptions map[string]types.String `tfsdk:"data_mask_options"`
+}
+
+// RangerRowFilterDefModel describes the row filter section of a service definition.
+type RangerRowFilterDefModel struct {
+	AccessTypes []RangerAccessTypeDefModel `tfsdk:"access_types"`
+	Resources   []RangerResourceDefModel   `tfsdk:"resources"`
+}
+
+// Metadata returns the data source type name.
+func (d *RangerServiceDefinitionDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_service_definition"
+}
+
+// Schema defines the schema for the data source.
+func (d *RangerServiceDefinitionDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Retrieve an Apache Ranger service definition (service-def), which describes what the policies of a service type may contain. Exactly one of `id` or `name` must be set",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The internal ID of the service definition in Apache Ranger",
+				Optional:            true,
+				Computed:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the service definition, which is the `type` of its services (e.g., `hive`, `hdfs`)",
+				Optional:            true,
+				Computed:            true,
+			},
+			"label": schema.StringAttribute{
+				MarkdownDescription: "The label of the service definition shown in the Ranger UI",
+				Computed:            true,
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "A human-readable description of the service definition",
+				Computed:            true,
+			},
+			"resources": schema.ListNestedAttribute{
+				MarkdownDescription: "The resource components policies may protect, usable as keys of `resources` in `ranger_policy`",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: resourceDefDataSourceAttributes(),
+				},
+			},
+			"access_types": schema.ListNestedAttribute{
+				MarkdownDescription: "The access types policies may grant, usable in `permissions` in `ranger_policy`",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: accessTypeDefDataSourceAttributes(),
+				},
+			},
+			"policy_conditions": schema.ListNestedAttribute{
+				MarkdownDescription: "The conditions policy items may use, usable as `type` of a `condition` in `ranger_policy`",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"item_id": schema.Int64Attribute{
+							MarkdownDescription: "The ID of the condition within the service definition",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The name of the condition",
+							Computed:            true,
+						},
+						"label": schema.StringAttribute{
+							MarkdownDescription: "The label of the condition shown in the Ranger UI",
+							Computed:            true,
+						},
+						"description": schema.StringAttribute{
+							MarkdownDescription: "A human-readable description of the condition",
+							Computed:            true,
+						},
+						"evaluator": schema.StringAttribute{
+							MarkdownDescription: "The Java class evaluating the condition",
+							Computed:            true,
+						},
+						"evaluator_options": schema.MapAttribute{
+							ElementType:         types.StringType,
+							MarkdownDescription: "Options passed to the evaluator",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"data_mask_def": schema.SingleNestedAttribute{
+				MarkdownDescription: "How data masking policies of this service type are defined. Null if they are not supported",
+				Computed:            true,
+				Attributes: map[string]schema.Attribute{
+					"mask_types": schema.ListNestedAttribute{
+						MarkdownDescription: "The mask types usable as `data_mask_type` in `ranger_policy`",
+						Computed:            true,
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"item_id": schema.Int64Attribute{
+									MarkdownDescription: "The ID of the mask type within the service definition",
+									Computed:            true,
+								},
+								"name": schema.StringAttribute{
+									MarkdownDescription: "The name of the mask type (e.g., `MASK`, `MASK_HASH`)",
+									Computed:            true,
+								},
+								"label": schema.StringAttribute{
+									MarkdownDescription: "The label of the mask type shown in the Ranger UI",
+									Computed:            true,
+								},
+								"description": schema.StringAttribute{
+									MarkdownDescription: "A human-readable description of the mask type",
+									Computed:            true,
+								},
+								"transformer": schema.StringAttribute{
+									MarkdownDescription: "The expression template producing the masked value",
+									Computed:            true,
+								},
+								"data_mask_options": schema.MapAttribute{
+									ElementType:         types.StringType,
+									MarkdownDescription: "Options of the mask type",
+									Computed:            true,
+								},
+							},
+						},
+					},
+					"access_types": schema.ListNestedAttribute{
+						MarkdownDescription: "The access types data masking policies may use",
+						Computed:            true,
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: accessTypeDefDataSourceAttributes(),
+						},
+					},
+					"resources": schema.ListNestedAttribute{
+						MarkdownDescription: "The resource components data masking policies may protect",
+						Computed:            true,
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: resourceDefDataSourceAttributes(),
+						},
+					},
+				},
+			},
+			"row_filter_def": schema.SingleNestedAttribute{
+				MarkdownDescription: "How row filter policies of this service type are defined. Null if they are not supported",
+				Computed:            true,
+				Attributes: map[string]schema.Attribute{
+					"access_types": schema.ListNestedAttribute{
+						MarkdownDescription: "The access types row filter policies may use",
+						Computed:            true,
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: accessTypeDefDataSourceAttributes(),
+						},
+					},
+					"resources": schema.ListNestedAttribute{
+						MarkdownDescription: "The resource components row filter policies may protect",
+						Computed:            true,
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: resourceDefDataSourceAttributes(),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// resourceDefDataSourceAttributes returns the computed schema attributes of a
+// resource component of a service definition.
+func resourceDefDataSourceAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"item_id": schema.Int64Attribute{
+			MarkdownDescription: "The ID of the resource within the service definition",
+			Computed:            true,
+		},
+		"name": schema.StringAttribute{
+			MarkdownDescription: "The name of the resource (e.g., `database`, `path`)",
+			Computed:            true,
+		},
+		"type": schema.StringAttribute{
+			MarkdownDescription: "The type of the resource values (e.g., `string`, `path`)",
+			Computed:            true,
+		},
+		"level": schema.Int64Attribute{
+			MarkdownDescription: "The depth of the resource in the resource hierarchy",
+			Computed:            true,
+		},
+		"parent": schema.StringAttribute{
+			MarkdownDescription: "The name of the parent resource in the hierarchy",
+			Computed:            true,
+		},
+		"mandatory": schema.BoolAttribute{
+			MarkdownDescription: "Whether policies must set this resource",
+			Computed:            true,
+		},
+		"lookup_supported": schema.BoolAttribute{
+			MarkdownDescription: "Whether Ranger can look up values of this resource",
+			Computed:            true,
+		},
+		"recursive_supported": schema.BoolAttribute{
+			MarkdownDescription: "Whether `is_recursive` may be set for this resource",
+			Computed:            true,
+		},
+		"excludes_supported": schema.BoolAttribute{
+			MarkdownDescription: "Whether `is_exclude` may be set for this resource",
+			Computed:            true,
+		},
+		"matcher": schema.StringAttribute{
+			MarkdownDescription: "The Java class matching resource values",
+			Computed:            true,
+		},
+		"matcher_options": schema.MapAttribute{
+			ElementType:         types.StringType,
+			MarkdownDescription: "Options passed to the matcher (e.g., `wildCard`, `ignoreCase`)",
+			Computed:            true,
+		},
+		"validation_regex": schema.StringAttribute{
+			MarkdownDescription: "The regular expression resource values must match",
+			Computed:            true,
+		},
+		"validation_message": schema.StringAttribute{
+			MarkdownDescription: "The message shown when a value does not match `validation_regex`",
+			Computed:            true,
+		},
+		"ui_hint": schema.StringAttribute{
+			MarkdownDescription: "A JSON hint for the Ranger UI",
+			Computed:            true,
+		},
+		"label": schema.StringAttribute{
+			MarkdownDescription: "The label of the resource shown in the Ranger UI",
+			Computed:            true,
+		},
+		"description": schema.StringAttribute{
+			MarkdownDescription: "A human-readable description of the resource",
+			Computed:            true,
+		},
+		"access_type_restrictions": schema.ListAttribute{
+			ElementType:         types.StringType,
+			MarkdownDescription: "The only access types policies on this resource may grant. Empty if all are allowed",
+			Computed:            true,
+		},
+		"is_valid_leaf": schema.BoolAttribute{
+			MarkdownDescription: "Whether a policy may stop at this resource of the hierarchy",
+			Computed:            true,
+		},
+	}
+}
+
+// accessTypeDefDataSourceAttributes returns the computed schema attributes of
+// an access type of a service definition.
+func accessTypeDefDataSourceAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"item_id": schema.Int64Attribute{
+			MarkdownDescription: "The ID of the access type within the service definition",
+			Computed:            true,
+		},
+		"name": schema.StringAttribute{
+			MarkdownDescription: "The name of the access type (e.g., `select`, `read`)",
+			Computed:            true,
+		},
+		"label": schema.StringAttribute{
+			MarkdownDescription: "The label of the access type shown in the Ranger UI",
+			Computed:            true,
+		},
+		"implied_grants": schema.ListAttribute{
+			ElementType:         types.StringType,
+			MarkdownDescription: "The access types granted along with this one",
+			Computed:            true,
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *RangerServiceDefinitionDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*RangerClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *RangerClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+// ValidateConfig checks that the service definition is looked up either by ID or by name.
+func (d *RangerServiceDefinitionDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var id, name types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("id"), &id)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("name"), &name)...)
+	if resp.Diagnostics.HasError() || id.IsUnknown() || name.IsUnknown() {
+		return
+	}
+
+	if id.IsNull() == name.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("name"),
+			"Invalid Ranger Service Definition Lookup",
+			"Exactly one of id or name must be set.",
+		)
+	}
+}
+
+// Read reads the data source.
+func (d *RangerServiceDefinitionDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data RangerServiceDefinitionDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading Ranger service definition", map[string]interface{}{
+		"name": data.Name.ValueString(),
+		"id":   data.ID.ValueString(),
+	})
+
+	// If an ID is provided, look up the service definition by ID, otherwise by name
+	var serviceDef *ranger.ServiceDef
+	var err error
+	var lookup string
+	if !data.ID.IsNull() {
+		lookup = fmt.Sprintf("ID %s", data.ID.ValueString())
+
+		id, parseErr := parseInt64(data.ID.ValueString())
+		if parseErr != nil {
+			resp.Diagnostics.AddError(
+				"Error Reading Ranger Service Definition",
+				fmt.Sprintf("Could not parse service definition ID: %s", parseErr),
+			)
+			return
+		}
+		serviceDef, err = d.client.GetServiceDef(ctx, id)
+	} else {
+		lookup = fmt.Sprintf("name %q", data.Name.ValueString())
+		serviceDef, err = d.client.GetServiceDefByName(ctx, data.Name.ValueString())
+	}
+
+	if ranger.IsNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Ranger Service Definition Not Found",
+			fmt.Sprintf("No service definition found with %s", lookup),
+		)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Ranger Service Definition",
+			fmt.Sprintf("Could not read service definition with %s: %s", lookup, err),
+		)
+		return
+	}
+
+	data = RangerServiceDefinitionDataSourceModel{
+		ID:               types.StringValue(fmt.Sprintf("%d", serviceDef.ID)),
+		Name:             types.StringValue(serviceDef.Name),
+		Label:            stringValueOrNull(serviceDef.Label),
+		Description:      stringValueOrNull(serviceDef.Description),
+		Resources:        convertResourceDefs(serviceDef.Resources),
+		AccessTypes:      convertAccessTypeDefs(serviceDef.AccessTypes),
+		PolicyConditions: convertPolicyConditionDefs(serviceDef.PolicyConditions),
+		DataMaskDef:      convertDataMaskDef(serviceDef.DataMaskDef),
+		RowFilterDef:     convertRowFilterDef(serviceDef.RowFilterDef),
+	}
+
+	// Set the state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// convertResourceDefs converts service definition resources to Terraform models.
+func convertResourceDefs(defs []ranger.ResourceDef) []RangerResourceDefModel {
+	models := make([]RangerResourceDefModel, 0, len(defs))
+	for _, def := range defs {
+		models = append(models, RangerResourceDefModel{
+			ItemID:                 types.Int64Value(def.ItemID),
+			Name:                   types.StringValue(def.Name),
+			Type:                   stringValueOrNull(def.Type),
+			Level:                  types.Int64Value(def.Level),
+			Parent:                 stringValueOrNull(def.Parent),
+			Mandatory:              types.BoolValue(def.Mandatory),
+			LookupSupported:        types.BoolValue(def.LookupSupported),
+			RecursiveSupported:     types.BoolValue(def.RecursiveSupported),
+			ExcludesSupported:      types.BoolValue(def.ExcludesSupported),
+			Matcher:                stringValueOrNull(def.Matcher),
+			MatcherOptions:         stringMapValues(def.MatcherOptions),
+			ValidationRegEx:        stringValueOrNull(def.ValidationRegEx),
+			ValidationMessage:      stringValueOrNull(def.ValidationMessage),
+			UIHint:                 stringValueOrNull(def.UIHint),
+			Label:                  stringValueOrNull(def.Label),
+			Description:            stringValueOrNull(def.Description),
+			AccessTypeRestrictions: stringValues(def.AccessTypeRestrictions),
+			IsValidLeaf:            types.BoolPointerValue(def.IsValidLeaf),
+		})
+	}
+	return models
+}
+
+// convertAccessTypeDefs converts service definition access types to Terraform models.
+func convertAccessTypeDefs(defs []ranger.AccessTypeDef) []RangerAccessTypeDefModel {
+	models := make([]RangerAccessTypeDefModel, 0, len(defs))
+	for _, def := range defs {
+		models = append(models, RangerAccessTypeDefModel{
+			ItemID:        types.Int64Value(def.ItemID),
+			Name:          types.StringValue(def.Name),
+			Label:         stringValueOrNull(def.Label),
+			ImpliedGrants: stringValues(def.ImpliedGrants),
+		})
+	}
+	return models
+}
+
+// convertPolicyConditionDefs converts service definition policy conditions to Terraform models.
+func convertPolicyConditionDefs(defs []ranger.PolicyConditionDef) []RangerPolicyConditionDefModel {
+	models := make([]RangerPolicyConditionDefModel, 0, len(defs))
+	for _, def := range defs {
+		models = append(models, RangerPolicyConditionDefModel{
+			ItemID:           types.Int64Value(def.ItemID),
+			Name:             types.StringValue(def.Name),
+			Label:            stringValueOrNull(def.Label),
+			Description:      stringValueOrNull(def.Description),
+			Evaluator:        stringValueOrNull(def.Evaluator),
+			EvaluatorOptions: stringMapValues(def.EvaluatorOptions),
+		})
+	}
+	return models
+}
+
+// convertDataMaskDef converts the data masking section of a service
+// definition to a Terraform model. It returns nil when masking is not supported.
+func convertDataMaskDef(def *ranger.DataMaskDef) *RangerDataMaskDefModel {
+	if def == nil || (len(def.MaskTypes) == 0 && len(def.AccessTypes) == 0 && len(def.Resources) == 0) {
+		return nil
+	}
+
+	model := &RangerDataMaskDefModel{
+		MaskTypes:   make([]RangerDataMaskTypeDefModel, 0, len(def.MaskTypes)),
+		AccessTypes: convertAccessTypeDefs(def.AccessTypes),
+		Resources:   convertResourceDefs(def.Resources),
+	}
+	for _, maskType := range def.MaskTypes {
+		model.MaskTypes = append(model.MaskTypes, RangerDataMaskTypeDefModel{
+			ItemID:          types.Int64Value(maskType.ItemID),
+			Name:            types.StringValue(maskType.Name),
+			Label:           stringValueOrNull(maskType.Label),
+			Description:     stringValueOrNull(maskType.Description),
+			Transformer:     stringValueOrNull(maskType.Transformer),
+			DataMaskOptions: stringMapValues(maskType.DataMaskOptions),
+		})
+	}
+	return model
+}
+
+// convertRowFilterDef converts the row filter section of a service
+// definition to a Terraform model. It returns nil when row filtering is not supported.
+func convertRowFilterDef(def *ranger.RowFilterDef) *RangerRowFilterDefModel {
+	if def == nil || (len(def.AccessTypes) == 0 && len(def.Resources) == 0) {
+		return nil
+	}
+
+	return &RangerRowFilterDefModel{
+		AccessTypes: convertAccessTypeDefs(def.AccessTypes),
+		Resources:   convertResourceDefs(def.Resources),
+	}
+}
@@ -0,0 +1,54 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-provider-scaffolding-framework/internal/ranger"
+)
+
+func TestServiceDefinitionDataSourceModel(t *testing.T) {
+	ctx := context.Background()
+
+	data, err := os.ReadFile("testdata/servicedef_hive.json")
+	if err != nil {
+		t.Fatalf("could not read recorded service definition: %s", err)
+	}
+
+	var serviceDef ranger.ServiceDef
+	if err := json.Unmarshal(data, &serviceDef); err != nil {
+		t.Fatalf("could not unmarshal recorded service definition: %s", err)
+	}
+
+	dataMaskDef := convertDataMaskDef(serviceDef.DataMaskDef)
+	if dataMaskDef == nil || len(dataMaskDef.MaskTypes) != 2 || !dataMaskDef.MaskTypes[1].Transformer.IsNull() {
+		t.Errorf("unexpected data mask def: %+v", dataMaskDef)
+	}
+	if convertRowFilterDef(serviceDef.RowFilterDef) != nil {
+		t.Errorf("expected an empty row filter def to be null")
+	}
+
+	resources := convertResourceDefs(serviceDef.Resources)
+	if resources[1].Parent.ValueString() != "database" || resources[1].MatcherOptions["wildCard"].ValueString() != "true" {
+		t.Errorf("unexpected resources: %+v", resources)
+	}
+
+	schemaResp := &datasource.SchemaResponse{}
+	(&RangerServiceDefinitionDataSource{}).Schema(ctx, datasource.SchemaRequest{}, schemaResp)
+	assertModelFitsSchema(t, schemaResp.Schema, &RangerServiceDefinitionDataSourceModel{
+		ID:               stringValueOrNull("3"),
+		Name:             stringValueOrNull(serviceDef.Name),
+		Label:            stringValueOrNull(serviceDef.Label),
+		Description:      stringValueOrNull(serviceDef.Description),
+		Resources:        resources,
+		AccessTypes:      convertAccessTypeDefs(serviceDef.AccessTypes),
+		PolicyConditions: convertPolicyConditionDefs(serviceDef.PolicyConditions),
+		DataMaskDef:      dataMaskDef,
+	})
+}
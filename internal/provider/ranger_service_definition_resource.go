@@ -0,0 +1,921 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-scaffolding-framework/internal/ranger"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &rangerServiceDefinitionResource{}
+	_ resource.ResourceWithImportState = &rangerServiceDefinitionResource{}
+)
+
+// NewRangerServiceDefinitionResource is a helper function to simplify the provider implementation.
+func NewRangerServiceDefinitionResource() resource.Resource {
+	return &rangerServiceDefinitionResource{}
+}
+
+// rangerServiceDefinitionResource is the resource implementation.
+type rangerServiceDefinitionResource struct {
+	client *RangerClient
+}
+
+// RangerServiceDefinitionResourceModel maps the resource schema to Go objects.
+type RangerServiceDefinitionResourceModel struct {
+	ID               types.String                    `tfsdk:"id"`
+	Name             types.String                    `tfsdk:"name"`
+	DisplayName      types.String                    `tfsdk:"display_name"`
+	ImplClass        types.String                    `tfsdk:"impl_class"`
+	Label            types.String                    `tfsdk:"label"`
+	Description      types.String                    `tfsdk:"description"`
+	Options          map[string]types.String         `tfsdk:"options"`
+	Configs          []RangerServiceConfigDefModel   `tfsdk:"configs"`
+	Enums            []RangerEnumDefModel            `tfsdk:"enums"`
+	Resources        []RangerResourceDefModel        `tfsdk:"resources"`
+	AccessTypes      []RangerAccessTypeDefModel      `tfsdk:"access_types"`
+	PolicyConditions []RangerPolicyConditionDefModel `tfsdk:"policy_conditions"`
+	ContextEnrichers []RangerContextEnricherDefModel `tfsdk:"context_enrichers"`
+	DataMaskDef      *RangerDataMaskDefModel         `tfsdk:"data_mask_def"`
+	RowFilterDef     *RangerRowFilterDefModel        `tfsdk:"row_filter_def"`
+}
+
+// RangerServiceConfigDefModel describes a configuration property of the services of a service type.
+type RangerServiceConfigDefModel struct {
+	ItemID            types.Int64  `tfsdk:"item_id"`
+	Name              types.String `tfsdk:"name"`
+	Type              types.String `tfsdk:"type"`
+	SubType           types.String `tfsdk:"sub_type"`
+	Mandatory         types.Bool   `tfsdk:"mandatory"`
+	DefaultValue      types.String `tfsdk:"default_value"`
+	ValidationRegEx   types.String `tfsdk:"validation_regex"`
+	ValidationMessage types.String `tfsdk:"validation_message"`
+	UIHint            types.String `tfsdk:"ui_hint"`
+	Label             types.String `tfsdk:"label"`
+	Description       types.String `tfsdk:"description"`
+}
+
+// RangerEnumDefModel describes an enum of a service definition.
+type RangerEnumDefModel struct {
+	ItemID       types.Int64                 `tfsdk:"item_id"`
+	Name         types.String                `tfsdk:"name"`
+	Elements     []RangerEnumElementDefModel `tfsdk:"elements"`
+	DefaultIndex types.Int64                 `tfsdk:"default_index"`
+}
+
+// RangerEnumElementDefModel describes a value of an enum.
+type RangerEnumElementDefModel struct {
+	ItemID types.Int64  `tfsdk:"item_id"`
+	Name   types.String `tfsdk:"name"`
+	Label  types.String `tfsdk:"label"`
+}
+
+// RangerContextEnricherDefModel describes a context enricher of a service definition.
+type RangerContextEnricherDefModel struct {
+	ItemID          types.Int64             `tfsdk:"item_id"`
+	Name            types.String            `tfsdk:"name"`
+	Enricher        types.String            `tfsdk:"enricher"`
+	EnricherOptions map[string]types.String `tfsdk:"enricher_options"`
+}
+
+// Metadata returns the resource type name.
+func (r *rangerServiceDefinitionResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_service_definition"
+}
+
+// Schema defines the schema for the resource.
+func (r *rangerServiceDefinitionResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Apache Ranger service definition (service-def) resource, registering the service type of a custom Ranger plugin",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The internal ID of the service definition in Apache Ranger",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the service definition. Services of this type set it as their `type`",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"display_name": schema.StringAttribute{
+				MarkdownDescription: "The name of the service type shown in the Ranger UI. Defaults to `name`",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"impl_class": schema.StringAttribute{
+				MarkdownDescription: "The Java class implementing the service in Ranger Admin (resource lookup and connection test)",
+				Optional:            true,
+			},
+			"label": schema.StringAttribute{
+				MarkdownDescription: "The label of the service definition",
+				Optional:            true,
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "A human-readable description of the service definition",
+				Optional:            true,
+			},
+			"options": schema.MapAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "Options of the service definition (e.g., `enableDenyAndExceptionsInPolicies`)",
+				Optional:            true,
+			},
+			"configs": schema.ListNestedAttribute{
+				MarkdownDescription: "The configuration properties of the services of this type",
+				Optional:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"item_id": schema.Int64Attribute{
+							MarkdownDescription: "The ID of the config, unique within the configs",
+							Required:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The name of the config, used as key in the `configs` of `ranger_service`",
+							Required:            true,
+						},
+						"type": schema.StringAttribute{
+							MarkdownDescription: "The type of the config value (`string`, `bool`, `int`, `enum`, `password`, `path`)",
+							Required:            true,
+						},
+						"sub_type": schema.StringAttribute{
+							MarkdownDescription: "The name of the enum for `enum` configs, or the values of `bool` configs (e.g., `YesTrue:NoFalse`)",
+							Optional:            true,
+						},
+						"mandatory": schema.BoolAttribute{
+							MarkdownDescription: "Whether services must set the config",
+							Optional:            true,
+							Computed:            true,
+							Default:             booldefault.StaticBool(false),
+						},
+						"default_value": schema.StringAttribute{
+							MarkdownDescription: "The value used when a service does not set the config",
+							Optional:            true,
+						},
+						"validation_regex": schema.StringAttribute{
+							MarkdownDescription: "The regular expression config values must match",
+							Optional:            true,
+						},
+						"validation_message": schema.StringAttribute{
+							MarkdownDescription: "The message shown when a value does not match `validation_regex`",
+							Optional:            true,
+						},
+						"ui_hint": schema.StringAttribute{
+							MarkdownDescription: "A JSON hint for the Ranger UI",
+							Optional:            true,
+						},
+						"label": schema.StringAttribute{
+							MarkdownDescription: "The label of the config shown in the Ranger UI",
+							Optional:            true,
+						},
+						"description": schema.StringAttribute{
+							MarkdownDescription: "A human-readable description of the config",
+							Optional:            true,
+						},
+					},
+				},
+			},
+			"enums": schema.ListNestedAttribute{
+				MarkdownDescription: "Named sets of values that `enum` configs refer to through their `sub_type`",
+				Optional:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"item_id": schema.Int64Attribute{
+							MarkdownDescription: "The ID of the enum, unique within the enums",
+							Required:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The name of the enum",
+							Required:            true,
+						},
+						"elements": schema.ListNestedAttribute{
+							MarkdownDescription: "The values of the enum",
+							Required:            true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"item_id": schema.Int64Attribute{
+										MarkdownDescription: "The ID of the value, unique within the enum",
+										Required:            true,
+									},
+									"name": schema.StringAttribute{
+										MarkdownDescription: "The value",
+										Required:            true,
+									},
+									"label": schema.StringAttribute{
+										MarkdownDescription: "The label of the value shown in the Ranger UI",
+										Optional:            true,
+									},
+								},
+							},
+						},
+						"default_index": schema.Int64Attribute{
+							MarkdownDescription: "The index in `elements` of the default value",
+							Optional:            true,
+							Computed:            true,
+							Default:             int64default.StaticInt64(0),
+						},
+					},
+				},
+			},
+			"resources": schema.ListNestedAttribute{
+				MarkdownDescription: "The resource components policies of this type protect, forming a hierarchy through `parent` and `level`",
+				Required:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: resourceDefAttributes(),
+				},
+			},
+			"access_types": schema.ListNestedAttribute{
+				MarkdownDescription: "The access types policies of this type may grant",
+				Required:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: accessTypeDefAttributes(),
+				},
+			},
+			"policy_conditions": schema.ListNestedAttribute{
+				MarkdownDescription: "The conditions policy items of this type may use",
+				Optional:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"item_id": schema.Int64Attribute{
+							MarkdownDescription: "The ID of the condition, unique within the policy conditions",
+							Required:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The name of the condition, used as `type` of a `condition` in `ranger_policy`",
+							Required:            true,
+						},
+						"label": schema.StringAttribute{
+							MarkdownDescription: "The label of the condition shown in the Ranger UI",
+							Optional:            true,
+						},
+						"description": schema.StringAttribute{
+							MarkdownDescription: "A human-readable description of the condition",
+							Optional:            true,
+						},
+						"evaluator": schema.StringAttribute{
+							MarkdownDescription: "The Java class evaluating the condition",
+							Required:            true,
+						},
+						"evaluator_options": schema.MapAttribute{
+							ElementType:         types.StringType,
+							MarkdownDescription: "Options passed to the evaluator",
+							Optional:            true,
+						},
+					},
+				},
+			},
+			"context_enrichers": schema.ListNestedAttribute{
+				MarkdownDescription: "Plugin components adding information (tags, geolocation, ...) to access requests before policies are evaluated",
+				Optional:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"item_id": schema.Int64Attribute{
+							MarkdownDescription: "The ID of the context enricher, unique within the context enrichers",
+							Required:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The name of the context enricher",
+							Required:            true,
+						},
+						"enricher": schema.StringAttribute{
+							MarkdownDescription: "The Java class of the context enricher",
+							Required:            true,
+						},
+						"enricher_options": schema.MapAttribute{
+							ElementType:         types.StringType,
+							MarkdownDescription: "Options passed to the context enricher",
+							Optional:            true,
+						},
+					},
+				},
+			},
+			"data_mask_def": schema.SingleNestedAttribute{
+				MarkdownDescription: "Enables data masking policies for this type",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"mask_types": schema.ListNestedAttribute{
+						MarkdownDescription: "The ways column values can be masked",
+						Required:            true,
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"item_id": schema.Int64Attribute{
+									MarkdownDescription: "The ID of the mask type, unique within the mask types",
+									Required:            true,
+								},
+								"name": schema.StringAttribute{
+									MarkdownDescription: "The name of the mask type, used as `data_mask_type` in `ranger_policy`",
+									Required:            true,
+								},
+								"label": schema.StringAttribute{
+									MarkdownDescription: "The label of the mask type shown in the Ranger UI",
+									Optional:            true,
+								},
+								"description": schema.StringAttribute{
+									MarkdownDescription: "A human-readable description of the mask type",
+									Optional:            true,
+								},
+								"transformer": schema.StringAttribute{
+									MarkdownDescription: "The expression template producing the masked value, with `{col}` standing for the column",
+									Optional:            true,
+								},
+								"data_mask_options": schema.MapAttribute{
+									ElementType:         types.StringType,
+									MarkdownDescription: "Options of the mask type",
+									Optional:            true,
+								},
+							},
+						},
+					},
+					"access_types": schema.ListNestedAttribute{
+						MarkdownDescription: "The access types data masking policies may use. Their names must be defined in `access_types`",
+						Required:            true,
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: accessTypeDefAttributes(),
+						},
+					},
+					"resources": schema.ListNestedAttribute{
+						MarkdownDescription: "The resource components data masking policies may protect. Their names must be defined in `resources`",
+						Required:            true,
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: resourceDefAttributes(),
+						},
+					},
+				},
+			},
+			"row_filter_def": schema.SingleNestedAttribute{
+				MarkdownDescription: "Enables row filter policies for this type",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"access_types": schema.ListNestedAttribute{
+						MarkdownDescription: "The access types row filter policies may use. Their names must be defined in `access_types`",
+						Required:            true,
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: accessTypeDefAttributes(),
+						},
+					},
+					"resources": schema.ListNestedAttribute{
+						MarkdownDescription: "The resource components row filter policies may protect. Their names must be defined in `resources`",
+						Required:            true,
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: resourceDefAttributes(),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// resourceDefAttributes returns the schema attributes of a resource
+// component of a service definition.
+func resourceDefAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"item_id": schema.Int64Attribute{
+			MarkdownDescription: "The ID of the resource, unique within the resources",
+			Required:            true,
+		},
+		"name": schema.StringAttribute{
+			MarkdownDescription: "The name of the resource, used as key of `resources` in `ranger_policy`",
+			Required:            true,
+		},
+		"type": schema.StringAttribute{
+			MarkdownDescription: "The type of the resource values (e.g., `string`, `path`)",
+			Required:            true,
+		},
+		"level": schema.Int64Attribute{
+			MarkdownDescription: "The depth of the resource in the resource hierarchy",
+			Required:            true,
+		},
+		"parent": schema.StringAttribute{
+			MarkdownDescription: "The name of the parent resource in the hierarchy",
+			Optional:            true,
+		},
+		"mandatory": schema.BoolAttribute{
+			MarkdownDescription: "Whether policies must set this resource",
+			Optional:            true,
+			Computed:            true,
+			Default:             booldefault.StaticBool(false),
+		},
+		"lookup_supported": schema.BoolAttribute{
+			MarkdownDescription: "Whether Ranger can look up values of this resource",
+			Optional:            true,
+			Computed:            true,
+			Default:             booldefault.StaticBool(false),
+		},
+		"recursive_supported": schema.BoolAttribute{
+			MarkdownDescription: "Whether `is_recursive` may be set for this resource",
+			Optional:            true,
+			Computed:            true,
+			Default:             booldefault.StaticBool(false),
+		},
+		"excludes_supported": schema.BoolAttribute{
+			MarkdownDescription: "Whether `is_exclude` may be set for this resource",
+			Optional:            true,
+			Computed:            true,
+			Default:             booldefault.StaticBool(false),
+		},
+		"matcher": schema.StringAttribute{
+			MarkdownDescription: "The Java class matching resource values",
+			Optional:            true,
+		},
+		"matcher_options": schema.MapAttribute{
+			ElementType:         types.StringType,
+			MarkdownDescription: "Options passed to the matcher (e.g., `wildCard`, `ignoreCase`)",
+			Optional:            true,
+		},
+		"validation_regex": schema.StringAttribute{
+			MarkdownDescription: "The regular expression resource values must match",
+			Optional:            true,
+		},
+		"validation_message": schema.StringAttribute{
+			MarkdownDescription: "The message shown when a value does not match `validation_regex`",
+			Optional:            true,
+		},
+		"ui_hint": schema.StringAttribute{
+			MarkdownDescription: "A JSON hint for the Ranger UI",
+			Optional:            true,
+		},
+		"label": schema.StringAttribute{
+			MarkdownDescription: "The label of the resource shown in the Ranger UI",
+			Optional:            true,
+		},
+		"description": schema.StringAttribute{
+			MarkdownDescription: "A human-readable description of the resource",
+			Optional:            true,
+		},
+		"access_type_restrictions": schema.ListAttribute{
+			ElementType:         types.StringType,
+			MarkdownDescription: "The only access types policies on this resource may grant",
+			Optional:            true,
+		},
+		"is_valid_leaf": schema.BoolAttribute{
+			MarkdownDescription: "Whether a policy may stop at this resource of the hierarchy. Derived by Ranger from the hierarchy when not set",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers: []planmodifier.Bool{
+				boolplanmodifier.UseStateForUnknown(),
+			},
+		},
+	}
+}
+
+// accessTypeDefAttributes returns the schema attributes of an access type of
+// a service definition.
+func accessTypeDefAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"item_id": schema.Int64Attribute{
+			MarkdownDescription: "The ID of the access type, unique within the access types",
+			Required:            true,
+		},
+		"name": schema.StringAttribute{
+			MarkdownDescription: "The name of the access type, used in `permissions` in `ranger_policy`",
+			Required:            true,
+		},
+		"label": schema.StringAttribute{
+			MarkdownDescription: "The label of the access type shown in the Ranger UI",
+			Optional:            true,
+		},
+		"implied_grants": schema.ListAttribute{
+			ElementType:         types.StringType,
+			MarkdownDescription: "The access types granted along with this one (e.g., `all` implying every other access type)",
+			Optional:            true,
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *rangerServiceDefinitionResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*RangerClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *RangerClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// Create creates a new Ranger service definition.
+func (r *rangerServiceDefinitionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan RangerServiceDefinitionResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	serviceDef := convertModelToServiceDef(plan)
+
+	createdServiceDef, err := r.client.CreateServiceDef(ctx, &serviceDef)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating Ranger Service Definition",
+			fmt.Sprintf("Could not create service definition %q: %s", serviceDef.Name, err),
+		)
+		return
+	}
+
+	setComputedServiceDefFields(&plan, *createdServiceDef)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "Created Ranger service definition", map[string]interface{}{
+		"id":   createdServiceDef.ID,
+		"name": createdServiceDef.Name,
+	})
+}
+
+// Read reads the Ranger service definition from the API.
+func (r *rangerServiceDefinitionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state RangerServiceDefinitionResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := parseInt64(state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Ranger Service Definition",
+			fmt.Sprintf("Could not parse service definition ID: %s", err),
+		)
+		return
+	}
+
+	serviceDef, err := r.client.GetServiceDef(ctx, id)
+	if ranger.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Ranger Service Definition",
+			fmt.Sprintf("Could not read service definition ID %d: %s", id, err),
+		)
+		return
+	}
+
+	model := convertServiceDefToModel(*serviceDef)
+
+	diags = resp.State.Set(ctx, model)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update updates an existing Ranger service definition.
+func (r *rangerServiceDefinitionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan RangerServiceDefinitionResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := parseInt64(plan.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Ranger Service Definition",
+			fmt.Sprintf("Could not parse service definition ID: %s", err),
+		)
+		return
+	}
+
+	serviceDef := convertModelToServiceDef(plan)
+	serviceDef.ID = id
+
+	updatedServiceDef, err := r.client.UpdateServiceDef(ctx, id, &serviceDef)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Ranger Service Definition",
+			fmt.Sprintf("Could not update service definition ID %d: %s", id, err),
+		)
+		return
+	}
+
+	setComputedServiceDefFields(&plan, *updatedServiceDef)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "Updated Ranger service definition", map[string]interface{}{
+		"id":   updatedServiceDef.ID,
+		"name": updatedServiceDef.Name,
+	})
+}
+
+// Delete deletes a Ranger service definition.
+func (r *rangerServiceDefinitionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state RangerServiceDefinitionResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := parseInt64(state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting Ranger Service Definition",
+			fmt.Sprintf("Could not parse service definition ID: %s", err),
+		)
+		return
+	}
+
+	err = r.client.DeleteServiceDef(ctx, id)
+	if err != nil && !ranger.IsNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Error Deleting Ranger Service Definition",
+			fmt.Sprintf("Could not delete service definition ID %d: %s", id, err),
+		)
+		return
+	}
+
+	tflog.Info(ctx, "Deleted Ranger service definition", map[string]interface{}{
+		"id": id,
+	})
+}
+
+// ImportState imports a Ranger service definition by name.
+func (r *rangerServiceDefinitionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	serviceDef, err := r.client.GetServiceDefByName(ctx, req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Importing Ranger Service Definition",
+			fmt.Sprintf("Could not find service definition %q: %s", req.ID, err),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), fmt.Sprintf("%d", serviceDef.ID))...)
+}
+
+// setComputedServiceDefFields fills the computed attributes of model that are
+// still unknown with the values Ranger returned.
+func setComputedServiceDefFields(model *RangerServiceDefinitionResourceModel, serviceDef ranger.ServiceDef) {
+	model.ID = types.StringValue(fmt.Sprintf("%d", serviceDef.ID))
+	if model.DisplayName.IsUnknown() {
+		model.DisplayName = stringValueOrNull(serviceDef.DisplayName)
+	}
+
+	setComputedResourceDefFields(model.Resources, serviceDef.Resources)
+	if model.DataMaskDef != nil && serviceDef.DataMaskDef != nil {
+		setComputedResourceDefFields(model.DataMaskDef.Resources, serviceDef.DataMaskDef.Resources)
+	}
+	if model.RowFilterDef != nil && serviceDef.RowFilterDef != nil {
+		setComputedResourceDefFields(model.RowFilterDef.Resources, serviceDef.RowFilterDef.Resources)
+	}
+}
+
+// setComputedResourceDefFields fills the unknown is_valid_leaf of the
+// resource models with the value Ranger derived for the resource of the same name.
+func setComputedResourceDefFields(models []RangerResourceDefModel, defs []ranger.ResourceDef) {
+	for i := range models {
+		if !models[i].IsValidLeaf.IsUnknown() {
+			continue
+		}
+
+		models[i].IsValidLeaf = types.BoolNull()
+		for _, def := range defs {
+			if def.Name == models[i].Name.ValueString() {
+				models[i].IsValidLeaf = types.BoolPointerValue(def.IsValidLeaf)
+				break
+			}
+		}
+	}
+}
+
+// convertModelToServiceDef converts a Terraform model to a Ranger service definition.
+func convertModelToServiceDef(model RangerServiceDefinitionResourceModel) ranger.ServiceDef {
+	serviceDef := ranger.ServiceDef{
+		Name:             model.Name.ValueString(),
+		DisplayName:      model.DisplayName.ValueString(),
+		ImplClass:        model.ImplClass.ValueString(),
+		Label:            model.Label.ValueString(),
+		Description:      model.Description.ValueString(),
+		Options:          stringMap(model.Options),
+		Resources:        convertResourceDefModels(model.Resources),
+		AccessTypes:      convertAccessTypeDefModels(model.AccessTypes),
+		PolicyConditions: make([]ranger.PolicyConditionDef, 0, len(model.PolicyConditions)),
+	}
+
+	for _, config := range model.Configs {
+		serviceDef.Configs = append(serviceDef.Configs, ranger.ServiceConfigDef{
+			ItemID:            config.ItemID.ValueInt64(),
+			Name:              config.Name.ValueString(),
+			Type:              config.Type.ValueString(),
+			SubType:           config.SubType.ValueString(),
+			Mandatory:         config.Mandatory.ValueBool(),
+			DefaultValue:      config.DefaultValue.ValueString(),
+			ValidationRegEx:   config.ValidationRegEx.ValueString(),
+			ValidationMessage: config.ValidationMessage.ValueString(),
+			UIHint:            config.UIHint.ValueString(),
+			Label:             config.Label.ValueString(),
+			Description:       config.Description.ValueString(),
+		})
+	}
+
+	for _, enum := range model.Enums {
+		enumDef := ranger.EnumDef{
+			ItemID:       enum.ItemID.ValueInt64(),
+			Name:         enum.Name.ValueString(),
+			Elements:     make([]ranger.EnumElementDef, 0, len(enum.Elements)),
+			DefaultIndex: enum.DefaultIndex.ValueInt64(),
+		}
+		for _, element := range enum.Elements {
+			enumDef.Elements = append(enumDef.Elements, ranger.EnumElementDef{
+				ItemID: element.ItemID.ValueInt64(),
+				Name:   element.Name.ValueString(),
+				Label:  element.Label.ValueString(),
+			})
+		}
+		serviceDef.Enums = append(serviceDef.Enums, enumDef)
+	}
+
+	for _, condition := range model.PolicyConditions {
+		serviceDef.PolicyConditions = append(serviceDef.PolicyConditions, ranger.PolicyConditionDef{
+			ItemID:           condition.ItemID.ValueInt64(),
+			Name:             condition.Name.ValueString(),
+			Label:            condition.Label.ValueString(),
+			Description:      condition.Description.ValueString(),
+			Evaluator:        condition.Evaluator.ValueString(),
+			EvaluatorOptions: stringMap(condition.EvaluatorOptions),
+		})
+	}
+
+	for _, enricher := range model.ContextEnrichers {
+		serviceDef.ContextEnrichers = append(serviceDef.ContextEnrichers, ranger.ContextEnricherDef{
+			ItemID:          enricher.ItemID.ValueInt64(),
+			Name:            enricher.Name.ValueString(),
+			Enricher:        enricher.Enricher.ValueString(),
+			EnricherOptions: stringMap(enricher.EnricherOptions),
+		})
+	}
+
+	if model.DataMaskDef != nil {
+		serviceDef.DataMaskDef = &ranger.DataMaskDef{
+			AccessTypes: convertAccessTypeDefModels(model.DataMaskDef.AccessTypes),
+			Resources:   convertResourceDefModels(model.DataMaskDef.Resources),
+		}
+		for _, maskType := range model.DataMaskDef.MaskTypes {
+			serviceDef.DataMaskDef.MaskTypes = append(serviceDef.DataMaskDef.MaskTypes, ranger.DataMaskTypeDef{
+				ItemID:          maskType.ItemID.ValueInt64(),
+				Name:            maskType.Name.ValueString(),
+				Label:           maskType.Label.ValueString(),
+				Description:     maskType.Description.ValueString(),
+				Transformer:     maskType.Transformer.ValueString(),
+				DataMaskOptions: stringMap(maskType.DataMaskOptions),
+			})
+		}
+	}
+
+	if model.RowFilterDef != nil {
+		serviceDef.RowFilterDef = &ranger.RowFilterDef{
+			AccessTypes: convertAccessTypeDefModels(model.RowFilterDef.AccessTypes),
+			Resources:   convertResourceDefModels(model.RowFilterDef.Resources),
+		}
+	}
+
+	return serviceDef
+}
+
+// convertServiceDefToModel converts a Ranger service definition to a Terraform model.
+func convertServiceDefToModel(serviceDef ranger.ServiceDef) RangerServiceDefinitionResourceModel {
+	model := RangerServiceDefinitionResourceModel{
+		ID:           types.StringValue(fmt.Sprintf("%d", serviceDef.ID)),
+		Name:         types.StringValue(serviceDef.Name),
+		DisplayName:  stringValueOrNull(serviceDef.DisplayName),
+		ImplClass:    stringValueOrNull(serviceDef.ImplClass),
+		Label:        stringValueOrNull(serviceDef.Label),
+		Description:  stringValueOrNull(serviceDef.Description),
+		Options:      stringMapValues(serviceDef.Options),
+		Resources:    convertResourceDefs(serviceDef.Resources),
+		AccessTypes:  convertAccessTypeDefs(serviceDef.AccessTypes),
+		DataMaskDef:  convertDataMaskDef(serviceDef.DataMaskDef),
+		RowFilterDef: convertRowFilterDef(serviceDef.RowFilterDef),
+	}
+
+	// Optional lists are left null when empty, so they match configurations omitting them
+	for _, config := range serviceDef.Configs {
+		model.Configs = append(model.Configs, RangerServiceConfigDefModel{
+			ItemID:            types.Int64Value(config.ItemID),
+			Name:              types.StringValue(config.Name),
+			Type:              types.StringValue(config.Type),
+			SubType:           stringValueOrNull(config.SubType),
+			Mandatory:         types.BoolValue(config.Mandatory),
+			DefaultValue:      stringValueOrNull(config.DefaultValue),
+			ValidationRegEx:   stringValueOrNull(config.ValidationRegEx),
+			ValidationMessage: stringValueOrNull(config.ValidationMessage),
+			UIHint:            stringValueOrNull(config.UIHint),
+			Label:             stringValueOrNull(config.Label),
+			Description:       stringValueOrNull(config.Description),
+		})
+	}
+
+	for _, enum := range serviceDef.Enums {
+		enumModel := RangerEnumDefModel{
+			ItemID:       types.Int64Value(enum.ItemID),
+			Name:         types.StringValue(enum.Name),
+			Elements:     make([]RangerEnumElementDefModel, 0, len(enum.Elements)),
+			DefaultIndex: types.Int64Value(enum.DefaultIndex),
+		}
+		for _, element := range enum.Elements {
+			enumModel.Elements = append(enumModel.Elements, RangerEnumElementDefModel{
+				ItemID: types.Int64Value(element.ItemID),
+				Name:   types.StringValue(element.Name),
+				Label:  stringValueOrNull(element.Label),
+			})
+		}
+		model.Enums = append(model.Enums, enumModel)
+	}
+
+	if len(serviceDef.PolicyConditions) > 0 {
+		model.PolicyConditions = convertPolicyConditionDefs(serviceDef.PolicyConditions)
+	}
+
+	for _, enricher := range serviceDef.ContextEnrichers {
+		model.ContextEnrichers = append(model.ContextEnrichers, RangerContextEnricherDefModel{
+			ItemID:          types.Int64Value(enricher.ItemID),
+			Name:            types.StringValue(enricher.Name),
+			Enricher:        types.StringValue(enricher.Enricher),
+			EnricherOptions: stringMapValues(enricher.EnricherOptions),
+		})
+	}
+
+	return model
+}
+
+// convertResourceDefModels converts Terraform resource models to service definition resources.
+func convertResourceDefModels(models []RangerResourceDefModel) []ranger.ResourceDef {
+	defs := make([]ranger.ResourceDef, 0, len(models))
+	for _, model := range models {
+		defs = append(defs, ranger.ResourceDef{
+			ItemID:                 model.ItemID.ValueInt64(),
+			Name:                   model.Name.ValueString(),
+			Type:                   model.Type.ValueString(),
+			Level:                  model.Level.ValueInt64(),
+			Parent:                 model.Parent.ValueString(),
+			Mandatory:              model.Mandatory.ValueBool(),
+			LookupSupported:        model.LookupSupported.ValueBool(),
+			RecursiveSupported:     model.RecursiveSupported.ValueBool(),
+			ExcludesSupported:      model.ExcludesSupported.ValueBool(),
+			Matcher:                model.Matcher.ValueString(),
+			MatcherOptions:         stringMap(model.MatcherOptions),
+			ValidationRegEx:        model.ValidationRegEx.ValueString(),
+			ValidationMessage:      model.ValidationMessage.ValueString(),
+			UIHint:                 model.UIHint.ValueString(),
+			Label:                  model.Label.ValueString(),
+			Description:            model.Description.ValueString(),
+			AccessTypeRestrictions: valueStrings(model.AccessTypeRestrictions),
+			IsValidLeaf:            model.IsValidLeaf.ValueBoolPointer(),
+		})
+	}
+	return defs
+}
+
+// convertAccessTypeDefModels converts Terraform access type models to service definition access types.
+func convertAccessTypeDefModels(models []RangerAccessTypeDefModel) []ranger.AccessTypeDef {
+	defs := make([]ranger.AccessTypeDef, 0, len(models))
+	for _, model := range models {
+		defs = append(defs, ranger.AccessTypeDef{
+			ItemID:        model.ItemID.ValueInt64(),
+			Name:          model.Name.ValueString(),
+			Label:         model.Label.ValueString(),
+			ImpliedGrants: valueStrings(model.ImpliedGrants),
+		})
+	}
+	return defs
+}
@@ -0,0 +1,70 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-scaffolding-framework/internal/ranger"
+)
+
+func TestServiceDefinitionModelRoundTrip(t *testing.T) {
+	ctx := context.Background()
+
+	data, err := os.ReadFile("testdata/servicedef_hive.json")
+	if err != nil {
+		t.Fatalf("could not read recorded service definition: %s", err)
+	}
+
+	var serviceDef ranger.ServiceDef
+	if err := json.Unmarshal(data, &serviceDef); err != nil {
+		t.Fatalf("could not unmarshal recorded service definition: %s", err)
+	}
+
+	model := convertServiceDefToModel(serviceDef)
+
+	schemaResp := &resource.SchemaResponse{}
+	(&rangerServiceDefinitionResource{}).Schema(ctx, resource.SchemaRequest{}, schemaResp)
+	assertModelFitsSchema(t, schemaResp.Schema, &model)
+
+	// Empty and absent lists marshal alike, so compare what is sent to Ranger
+	converted := convertModelToServiceDef(model)
+	converted.ID = serviceDef.ID
+	serviceDef.RowFilterDef = nil // empty in the recording, null in the model
+	got, _ := json.Marshal(converted)
+	want, _ := json.Marshal(serviceDef)
+	if string(got) != string(want) {
+		t.Errorf("service definition differs after round trip:\n got: %s\nwant: %s", got, want)
+	}
+}
+
+func TestSetComputedResourceDefFields(t *testing.T) {
+	isValidLeaf := true
+	models := []RangerResourceDefModel{
+		{Name: types.StringValue("database"), IsValidLeaf: types.BoolUnknown()},
+		{Name: types.StringValue("table"), IsValidLeaf: types.BoolValue(false)},
+		{Name: types.StringValue("column"), IsValidLeaf: types.BoolUnknown()},
+	}
+	defs := []ranger.ResourceDef{
+		{Name: "database", IsValidLeaf: &isValidLeaf},
+		{Name: "table", IsValidLeaf: &isValidLeaf},
+	}
+
+	setComputedResourceDefFields(models, defs)
+
+	if !models[0].IsValidLeaf.ValueBool() {
+		t.Errorf("expected the derived value to fill an unknown is_valid_leaf")
+	}
+	if models[1].IsValidLeaf.ValueBool() {
+		t.Errorf("expected a configured is_valid_leaf to be kept")
+	}
+	if !models[2].IsValidLeaf.IsNull() {
+		t.Errorf("expected is_valid_leaf of a resource Ranger did not return to be null, got %s", models[2].IsValidLeaf)
+	}
+}
@@ -0,0 +1,409 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-scaffolding-framework/internal/ranger"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &rangerServiceResource{}
+	_ resource.ResourceWithImportState = &rangerServiceResource{}
+)
+
+// NewRangerServiceResource is a helper function to simplify the provider implementation.
+func NewRangerServiceResource() resource.Resource {
+	return &rangerServiceResource{}
+}
+
+// rangerServiceResource is the resource implementation.
+type rangerServiceResource struct {
+	client *RangerClient
+}
+
+// RangerServiceResourceModel maps the resource schema to Go objects.
+type RangerServiceResourceModel struct {
+	ID               types.String            `tfsdk:"id"`
+	Name             types.String            `tfsdk:"name"`
+	Type             types.String            `tfsdk:"type"`
+	Description      types.String            `tfsdk:"description"`
+	IsEnabled        types.Bool              `tfsdk:"is_enabled"`
+	TagService       types.String            `tfsdk:"tag_service"`
+	Configs          map[string]types.String `tfsdk:"configs"`
+	SensitiveConfigs map[string]types.String `tfsdk:"sensitive_configs"`
+}
+
+// Metadata returns the resource type name.
+func (r *rangerServiceResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_service"
+}
+
+// Schema defines the schema for the resource.
+func (r *rangerServiceResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Apache Ranger Service (repository) resource",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The internal ID of the service in Apache Ranger",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the Ranger service. Policies refer to the service by this name",
+				Required:            true,
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "The service-def type of the service (e.g., `hdfs`, `hive`, `kafka`, `tag`)",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "A human-readable description of the service",
+				Optional:            true,
+			},
+			"is_enabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether the service is enabled (`true` by default)",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"tag_service": schema.StringAttribute{
+				MarkdownDescription: "The name of the tag service whose tag-based policies also apply to this service",
+				Optional:            true,
+			},
+			"configs": schema.MapAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "Connection and plugin configuration of the service (e.g., `username`, `jdbc.url`). Configs Ranger adds itself are left untouched. An imported service tracks no configs until the first apply, which records the configured ones without changing the others",
+				Optional:            true,
+			},
+			"sensitive_configs": schema.MapAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "Configuration values that must not be shown in plan output, such as `password`. Ranger never returns these values, so changes made outside Terraform are not detected",
+				Optional:            true,
+				Sensitive:           true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *rangerServiceResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*RangerClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *RangerClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// Create creates a new Ranger service.
+func (r *rangerServiceResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan RangerServiceResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	service, diags := convertModelToService(plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createdService, err := r.client.CreateService(ctx, &service)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating Ranger Service",
+			fmt.Sprintf("Could not create service %q: %s", service.Name, err),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(fmt.Sprintf("%d", createdService.ID))
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "Created Ranger service", map[string]interface{}{
+		"id":   createdService.ID,
+		"name": createdService.Name,
+	})
+}
+
+// Read reads the Ranger service from the API.
+func (r *rangerServiceResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state RangerServiceResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if state.ID.IsNull() {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	id, err := parseInt64(state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Ranger Service",
+			fmt.Sprintf("Could not parse service ID: %s", err),
+		)
+		return
+	}
+
+	service, err := r.client.GetService(ctx, id)
+	if ranger.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Ranger Service",
+			fmt.Sprintf("Could not read service ID %d: %s", id, err),
+		)
+		return
+	}
+
+	model := convertServiceToModel(*service, state)
+
+	diags = resp.State.Set(ctx, model)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update updates an existing Ranger service.
+func (r *rangerServiceResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state RangerServiceResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	service, diags := convertModelToService(plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := parseInt64(plan.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Ranger Service",
+			fmt.Sprintf("Could not parse service ID: %s", err),
+		)
+		return
+	}
+	service.ID = id
+
+	// The update replaces every config, so configs Terraform does not manage
+	// are sent back as Ranger has them
+	currentService, err := r.client.GetService(ctx, id)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Ranger Service",
+			fmt.Sprintf("Could not read service ID %d: %s", id, err),
+		)
+		return
+	}
+	service.Configs = mergeServiceConfigs(currentService.Configs, service.Configs, state)
+
+	updatedService, err := r.client.UpdateService(ctx, id, &service)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Ranger Service",
+			fmt.Sprintf("Could not update service ID %d: %s", id, err),
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "Updated Ranger service", map[string]interface{}{
+		"id":   updatedService.ID,
+		"name": updatedService.Name,
+	})
+}
+
+// Delete deletes a Ranger service.
+func (r *rangerServiceResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state RangerServiceResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := parseInt64(state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting Ranger Service",
+			fmt.Sprintf("Could not parse service ID: %s", err),
+		)
+		return
+	}
+
+	err = r.client.DeleteService(ctx, id)
+	if err != nil && !ranger.IsNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Error Deleting Ranger Service",
+			fmt.Sprintf("Could not delete service ID %d: %s", id, err),
+		)
+		return
+	}
+
+	tflog.Info(ctx, "Deleted Ranger service", map[string]interface{}{
+		"id": id,
+	})
+}
+
+// ImportState imports a Ranger service by name.
+func (r *rangerServiceResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	service, err := r.client.GetServiceByName(ctx, req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Importing Ranger Service",
+			fmt.Sprintf("Could not find service %q: %s", req.ID, err),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), fmt.Sprintf("%d", service.ID))...)
+}
+
+// convertModelToService converts a Terraform model to a Ranger service.
+func convertModelToService(model RangerServiceResourceModel) (ranger.Service, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	service := ranger.Service{
+		Name:        model.Name.ValueString(),
+		Type:        model.Type.ValueString(),
+		Description: model.Description.ValueString(),
+		IsEnabled:   model.IsEnabled.ValueBool(),
+		TagService:  model.TagService.ValueString(),
+		Configs:     make(map[string]string, len(model.Configs)+len(model.SensitiveConfigs)),
+	}
+
+	for key, value := range model.Configs {
+		service.Configs[key] = value.ValueString()
+	}
+
+	for key, value := range model.SensitiveConfigs {
+		if _, ok := service.Configs[key]; ok {
+			diags.AddAttributeError(
+				path.Root("sensitive_configs").AtMapKey(key),
+				"Duplicate Service Config",
+				fmt.Sprintf("The config %q is set in both configs and sensitive_configs. Set it in only one of them.", key),
+			)
+			continue
+		}
+		service.Configs[key] = value.ValueString()
+	}
+
+	return service, diags
+}
+
+// mergeServiceConfigs returns the configs to send on update: the planned
+// configs, plus the current configs Terraform does not manage. Ranger adds
+// defaults of its own, and password-type configs are kept by sending their
+// masked value back. Configs managed in the prior state but no longer
+// planned are removed.
+func mergeServiceConfigs(current, planned map[string]string, prior RangerServiceResourceModel) map[string]string {
+	configs := make(map[string]string, len(current)+len(planned))
+	for key, value := range current {
+		_, managed := prior.Configs[key]
+		_, sensitive := prior.SensitiveConfigs[key]
+		if !managed && !sensitive {
+			configs[key] = value
+		}
+	}
+
+	for key, value := range planned {
+		configs[key] = value
+	}
+
+	return configs
+}
+
+// convertServiceToModel converts a Ranger service to a Terraform model. The
+// prior state is used to tell apart managed, sensitive and server-side configs.
+func convertServiceToModel(service ranger.Service, prior RangerServiceResourceModel) RangerServiceResourceModel {
+	model := RangerServiceResourceModel{
+		ID:          types.StringValue(fmt.Sprintf("%d", service.ID)),
+		Name:        types.StringValue(service.Name),
+		Type:        types.StringValue(service.Type),
+		Description: stringValueOrNull(service.Description),
+		IsEnabled:   types.BoolValue(service.IsEnabled),
+		TagService:  stringValueOrNull(service.TagService),
+	}
+
+	// Ranger masks password-type configs, so sensitive values are kept from
+	// state as long as the key still exists on the server.
+	if prior.SensitiveConfigs != nil {
+		model.SensitiveConfigs = make(map[string]types.String, len(prior.SensitiveConfigs))
+		for key, value := range prior.SensitiveConfigs {
+			if _, ok := service.Configs[key]; ok {
+				model.SensitiveConfigs[key] = value
+			}
+		}
+	}
+
+	// Only the keys already under management are tracked, because Ranger adds
+	// defaults of its own (e.g. audit filters) when a service is created. An
+	// import therefore adopts no configs; the configured ones are tracked
+	// once applied.
+	if prior.Configs == nil {
+		return model
+	}
+
+	model.Configs = make(map[string]types.String)
+	for key, value := range service.Configs {
+		priorValue, managed := prior.Configs[key]
+		switch {
+		case !managed:
+			continue
+		case value == ranger.MaskedPassword && managed:
+			model.Configs[key] = priorValue
+		case value == ranger.MaskedPassword:
+			continue
+		default:
+			model.Configs[key] = types.StringValue(value)
+		}
+	}
+
+	return model
+}
@@ -0,0 +1,93 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-scaffolding-framework/internal/ranger"
+)
+
+func TestConvertServiceToModelConfigs(t *testing.T) {
+	service := ranger.Service{
+		ID:   7,
+		Name: "hive_prod",
+		Type: "hive",
+		Configs: map[string]string{
+			"username":                    "hive",
+			"password":                    ranger.MaskedPassword,
+			"ranger.plugin.audit.filters": "[]",
+		},
+	}
+
+	// Import: no config is adopted, as Ranger's own defaults would show up
+	// as removals in the first plan
+	model := convertServiceToModel(service, RangerServiceResourceModel{})
+	if model.Configs != nil || model.SensitiveConfigs != nil {
+		t.Errorf("unexpected configs on import: %v, %v", model.Configs, model.SensitiveConfigs)
+	}
+
+	// Refresh: only managed keys are tracked and sensitive values come from state
+	prior := RangerServiceResourceModel{
+		Name:             types.StringValue("hive_prod"),
+		Configs:          map[string]types.String{"username": types.StringValue("old")},
+		SensitiveConfigs: map[string]types.String{"password": types.StringValue("secret")},
+	}
+	model = convertServiceToModel(service, prior)
+	if len(model.Configs) != 1 || model.Configs["username"].ValueString() != "hive" {
+		t.Errorf("unexpected configs on refresh: %v", model.Configs)
+	}
+	if model.SensitiveConfigs["password"].ValueString() != "secret" {
+		t.Errorf("sensitive config not preserved: %v", model.SensitiveConfigs)
+	}
+	if !model.Description.IsNull() || !model.TagService.IsNull() {
+		t.Error("expected empty description and tag service to be null")
+	}
+
+	// Refresh of a service declared without configs adopts none
+	model = convertServiceToModel(service, RangerServiceResourceModel{Name: types.StringValue("hive_prod")})
+	if model.Configs != nil || model.SensitiveConfigs != nil {
+		t.Errorf("unexpected configs on refresh without configs: %v, %v", model.Configs, model.SensitiveConfigs)
+	}
+}
+
+func TestMergeServiceConfigs(t *testing.T) {
+	current := map[string]string{
+		"username":                    "hive",
+		"password":                    ranger.MaskedPassword,
+		"jdbc.url":                    "jdbc:hive2://old:10000",
+		"ranger.plugin.audit.filters": "[]",
+	}
+	prior := RangerServiceResourceModel{
+		Name:    types.StringValue("hive_prod"),
+		Configs: map[string]types.String{"username": types.StringValue("hive"), "jdbc.url": types.StringValue("jdbc:hive2://old:10000")},
+	}
+
+	// jdbc.url is no longer configured, the password was left behind by import
+	configs := mergeServiceConfigs(current, map[string]string{"username": "hive2"}, prior)
+	expected := map[string]string{
+		"username":                    "hive2",
+		"password":                    ranger.MaskedPassword,
+		"ranger.plugin.audit.filters": "[]",
+	}
+	if !reflect.DeepEqual(configs, expected) {
+		t.Errorf("unexpected configs: %v", configs)
+	}
+}
+
+func TestMergeServiceConfigsAfterImport(t *testing.T) {
+	current := map[string]string{
+		"username":                    "hive",
+		"password":                    ranger.MaskedPassword,
+		"ranger.plugin.audit.filters": "[]",
+	}
+
+	// The first apply after import keeps the configs Ranger added itself
+	configs := mergeServiceConfigs(current, map[string]string{"username": "hive"}, RangerServiceResourceModel{})
+	if !reflect.DeepEqual(configs, current) {
+		t.Errorf("unexpected configs: %v", configs)
+	}
+}
@@ -0,0 +1,355 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-scaffolding-framework/internal/ranger"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &rangerTagDefinitionResource{}
+	_ resource.ResourceWithImportState    = &rangerTagDefinitionResource{}
+	_ resource.ResourceWithValidateConfig = &rangerTagDefinitionResource{}
+)
+
+// NewRangerTagDefinitionResource is a helper function to simplify the provider implementation.
+func NewRangerTagDefinitionResource() resource.Resource {
+	return &rangerTagDefinitionResource{}
+}
+
+// rangerTagDefinitionResource is the resource implementation.
+type rangerTagDefinitionResource struct {
+	client *RangerClient
+}
+
+// RangerTagDefinitionResourceModel maps the resource schema to Go objects.
+type RangerTagDefinitionResourceModel struct {
+	ID            types.String                 `tfsdk:"id"`
+	Name          types.String                 `tfsdk:"name"`
+	Source        types.String                 `tfsdk:"source"`
+	AttributeDefs []RangerTagAttributeDefModel `tfsdk:"attribute_defs"`
+}
+
+// RangerTagAttributeDefModel describes an attribute of a tag.
+type RangerTagAttributeDefModel struct {
+	Name types.String `tfsdk:"name"`
+	Type types.String `tfsdk:"type"`
+}
+
+// tagAttributeTypes are the Atlas primitive types tag attributes may have.
+var tagAttributeTypes = []string{"string", "boolean", "byte", "short", "int", "long", "float", "double", "biginteger", "bigdecimal", "date"}
+
+// Metadata returns the resource type name.
+func (r *rangerTagDefinitionResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_tag_definition"
+}
+
+// Schema defines the schema for the resource.
+func (r *rangerTagDefinitionResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Apache Ranger tag definition resource. Tag-based policies of a `tag` service grant access to the resources tagged with the definition, " +
+			"by setting its name as the `tag` resource of a `ranger_policy`",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The internal ID of the tag definition in Apache Ranger",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the tag (e.g. `PII`). Tag-based policies refer to the tag by this name",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"source": schema.StringAttribute{
+				MarkdownDescription: "Where the tag definition comes from, such as `Atlas` for definitions synchronized by tagsync",
+				Optional:            true,
+			},
+			"attribute_defs": schema.SetNestedAttribute{
+				MarkdownDescription: "The attributes of the tag, which policy conditions can refer to",
+				Optional:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The name of the attribute (e.g. `expiry_date`)",
+							Required:            true,
+						},
+						"type": schema.StringAttribute{
+							MarkdownDescription: fmt.Sprintf("The type of the attribute, one of %s", quotedList(tagAttributeTypes)),
+							Required:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// ValidateConfig checks the types of the attributes of the tag.
+func (r *rangerTagDefinitionResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var attributeDefs types.Set
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("attribute_defs"), &attributeDefs)...)
+	for _, element := range attributeDefs.Elements() {
+		attributeDef, ok := element.(types.Object)
+		if !ok {
+			continue
+		}
+
+		attrType, ok := attributeDef.Attributes()["type"].(types.String)
+		if !ok || attrType.IsNull() || attrType.IsUnknown() || containsString(tagAttributeTypes, attrType.ValueString()) {
+			continue
+		}
+		resp.Diagnostics.AddAttributeError(
+			path.Root("attribute_defs").AtSetValue(element).AtName("type"),
+			"Invalid Tag Attribute Type",
+			fmt.Sprintf("Type %q is not a tag attribute type. Valid types: %s.", attrType.ValueString(), quotedList(tagAttributeTypes)),
+		)
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *rangerTagDefinitionResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*RangerClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *RangerClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// Create creates a new Ranger tag definition.
+func (r *rangerTagDefinitionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan RangerTagDefinitionResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tagDef := convertModelToTagDef(plan)
+
+	createdTagDef, err := r.client.CreateTagDef(ctx, &tagDef)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating Ranger Tag Definition",
+			fmt.Sprintf("Could not create tag definition %q: %s", tagDef.Name, err),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(fmt.Sprintf("%d", createdTagDef.ID))
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "Created Ranger tag definition", map[string]interface{}{
+		"id":   createdTagDef.ID,
+		"name": createdTagDef.Name,
+	})
+}
+
+// Read reads the Ranger tag definition from the API.
+func (r *rangerTagDefinitionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state RangerTagDefinitionResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := parseInt64(state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Ranger Tag Definition",
+			fmt.Sprintf("Could not parse tag definition ID: %s", err),
+		)
+		return
+	}
+
+	tagDef, err := r.client.GetTagDef(ctx, id)
+	if ranger.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Ranger Tag Definition",
+			fmt.Sprintf("Could not read tag definition ID %d: %s", id, err),
+		)
+		return
+	}
+
+	model := convertTagDefToModel(*tagDef, state)
+
+	diags = resp.State.Set(ctx, model)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update updates an existing Ranger tag definition.
+func (r *rangerTagDefinitionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan RangerTagDefinitionResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := parseInt64(plan.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Ranger Tag Definition",
+			fmt.Sprintf("Could not parse tag definition ID: %s", err),
+		)
+		return
+	}
+
+	// The managed fields are overlaid on the tag definition as stored, so
+	// that the fields Ranger manages, such as its guid, are sent back
+	tagDef, err := r.client.GetTagDef(ctx, id)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Ranger Tag Definition",
+			fmt.Sprintf("Could not read tag definition ID %d: %s", id, err),
+		)
+		return
+	}
+
+	planned := convertModelToTagDef(plan)
+	tagDef.Name = planned.Name
+	tagDef.Source = planned.Source
+	tagDef.AttributeDefs = planned.AttributeDefs
+
+	updatedTagDef, err := r.client.UpdateTagDef(ctx, id, tagDef)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Ranger Tag Definition",
+			fmt.Sprintf("Could not update tag definition ID %d: %s", id, err),
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "Updated Ranger tag definition", map[string]interface{}{
+		"id":   updatedTagDef.ID,
+		"name": updatedTagDef.Name,
+	})
+}
+
+// Delete deletes a Ranger tag definition.
+func (r *rangerTagDefinitionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state RangerTagDefinitionResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := parseInt64(state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting Ranger Tag Definition",
+			fmt.Sprintf("Could not parse tag definition ID: %s", err),
+		)
+		return
+	}
+
+	err = r.client.DeleteTagDef(ctx, id)
+	if err != nil && !ranger.IsNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Error Deleting Ranger Tag Definition",
+			fmt.Sprintf("Could not delete tag definition ID %d: %s\n\nRanger refuses to delete a tag definition while resources are tagged with it.", id, err),
+		)
+		return
+	}
+
+	tflog.Info(ctx, "Deleted Ranger tag definition", map[string]interface{}{
+		"id": id,
+	})
+}
+
+// ImportState imports a Ranger tag definition by name.
+func (r *rangerTagDefinitionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	tagDef, err := r.client.GetTagDefByName(ctx, req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Importing Ranger Tag Definition",
+			fmt.Sprintf("Could not find tag definition %q: %s", req.ID, err),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), fmt.Sprintf("%d", tagDef.ID))...)
+}
+
+// convertModelToTagDef converts a Terraform model to a Ranger tag definition.
+func convertModelToTagDef(model RangerTagDefinitionResourceModel) ranger.TagDef {
+	tagDef := ranger.TagDef{
+		Name:          model.Name.ValueString(),
+		Source:        model.Source.ValueString(),
+		IsEnabled:     true,
+		AttributeDefs: make([]ranger.TagAttributeDef, 0, len(model.AttributeDefs)),
+	}
+
+	for _, attributeDef := range model.AttributeDefs {
+		tagDef.AttributeDefs = append(tagDef.AttributeDefs, ranger.TagAttributeDef{
+			Name: attributeDef.Name.ValueString(),
+			Type: attributeDef.Type.ValueString(),
+		})
+	}
+
+	return tagDef
+}
+
+// convertTagDefToModel converts a Ranger tag definition to a Terraform model.
+// Ranger returns an empty list for a tag without attributes, which is null
+// unless the prior state had an empty set.
+func convertTagDefToModel(tagDef ranger.TagDef, prior RangerTagDefinitionResourceModel) RangerTagDefinitionResourceModel {
+	model := RangerTagDefinitionResourceModel{
+		ID:     types.StringValue(fmt.Sprintf("%d", tagDef.ID)),
+		Name:   types.StringValue(tagDef.Name),
+		Source: stringValueOrNull(tagDef.Source),
+	}
+
+	if prior.AttributeDefs != nil {
+		model.AttributeDefs = []RangerTagAttributeDefModel{}
+	}
+	for _, attributeDef := range tagDef.AttributeDefs {
+		model.AttributeDefs = append(model.AttributeDefs, RangerTagAttributeDefModel{
+			Name: types.StringValue(attributeDef.Name),
+			Type: types.StringValue(attributeDef.Type),
+		})
+	}
+
+	return model
+}
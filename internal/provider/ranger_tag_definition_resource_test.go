@@ -0,0 +1,113 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-scaffolding-framework/internal/ranger"
+)
+
+func TestTagDefModelRoundTrip(t *testing.T) {
+	model := RangerTagDefinitionResourceModel{
+		ID:     types.StringValue("4"),
+		Name:   types.StringValue("PII"),
+		Source: types.StringNull(),
+		AttributeDefs: []RangerTagAttributeDefModel{
+			{Name: types.StringValue("expiry_date"), Type: types.StringValue("date")},
+		},
+	}
+
+	tagDef := convertModelToTagDef(model)
+	if !tagDef.IsEnabled || len(tagDef.AttributeDefs) != 1 || tagDef.AttributeDefs[0].Type != "date" {
+		t.Errorf("unexpected tag definition: %+v", tagDef)
+	}
+
+	tagDef.ID = 4
+	converted := convertTagDefToModel(tagDef, model)
+	if !converted.Source.IsNull() || converted.AttributeDefs[0].Name.ValueString() != "expiry_date" {
+		t.Errorf("unexpected model: %+v", converted)
+	}
+
+	// Ranger sends no attributes as an empty list, which is null unless the
+	// configuration has an empty set
+	restricted := ranger.TagDef{ID: 5, Name: "RESTRICTED", Source: "Atlas", AttributeDefs: []ranger.TagAttributeDef{}}
+	converted = convertTagDefToModel(restricted, RangerTagDefinitionResourceModel{})
+	if converted.AttributeDefs != nil || converted.Source.ValueString() != "Atlas" {
+		t.Errorf("unexpected model: %+v", converted)
+	}
+
+	converted = convertTagDefToModel(restricted, RangerTagDefinitionResourceModel{AttributeDefs: []RangerTagAttributeDefModel{}})
+	if converted.AttributeDefs == nil || len(converted.AttributeDefs) != 0 {
+		t.Errorf("expected an empty set, got: %+v", converted.AttributeDefs)
+	}
+}
+
+func TestTagDefValidateConfig(t *testing.T) {
+	plan := testResourcePlan(t, NewRangerTagDefinitionResource(), RangerTagDefinitionResourceModel{
+		ID:     types.StringNull(),
+		Name:   types.StringValue("PII"),
+		Source: types.StringNull(),
+		AttributeDefs: []RangerTagAttributeDefModel{
+			{Name: types.StringValue("expiry_date"), Type: types.StringValue("date")},
+			{Name: types.StringValue("owner"), Type: types.StringValue("varchar")},
+			{Name: types.StringValue("level"), Type: types.StringUnknown()},
+		},
+	})
+
+	resp := &resource.ValidateConfigResponse{}
+	(&rangerTagDefinitionResource{}).ValidateConfig(context.Background(), resource.ValidateConfigRequest{
+		Config: tfsdk.Config{Schema: plan.Schema, Raw: plan.Raw},
+	}, resp)
+
+	if resp.Diagnostics.ErrorsCount() != 1 || resp.Diagnostics[0].Summary() != "Invalid Tag Attribute Type" {
+		t.Fatalf("expected only the varchar attribute to be reported, got: %v", resp.Diagnostics)
+	}
+}
+
+func TestTagDefUpdateKeepsGUID(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			_, _ = w.Write([]byte(`{"id":4,"guid":"2f6c","isEnabled":true,"version":3,"name":"PII","source":"Atlas","attributeDefs":[]}`))
+		case http.MethodPut:
+			var body map[string]interface{}
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+				t.Fatalf("could not decode request: %s", err)
+			}
+			if body["guid"] != "2f6c" || body["version"] != float64(3) || body["source"] != nil {
+				t.Errorf("unexpected update: %v", body)
+			}
+			if attributeDefs, _ := body["attributeDefs"].([]interface{}); len(attributeDefs) != 1 {
+				t.Errorf("unexpected attributes: %v", body["attributeDefs"])
+			}
+			_ = json.NewEncoder(w).Encode(body)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	ctx := context.Background()
+	r := &rangerTagDefinitionResource{client: &RangerClient{Client: ranger.NewClient(ranger.Config{Endpoint: server.URL})}}
+	plan := testResourcePlan(t, NewRangerTagDefinitionResource(), RangerTagDefinitionResourceModel{
+		ID:     types.StringValue("4"),
+		Name:   types.StringValue("PII"),
+		Source: types.StringNull(),
+		AttributeDefs: []RangerTagAttributeDefModel{
+			{Name: types.StringValue("expiry_date"), Type: types.StringValue("date")},
+		},
+	})
+
+	resp := &resource.UpdateResponse{State: tfsdk.State{Schema: plan.Schema}}
+	r.Update(ctx, resource.UpdateRequest{Plan: plan}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+}
@@ -0,0 +1,199 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-scaffolding-framework/internal/ranger"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &RangerUserDataSource{}
+
+// NewRangerUserDataSource creates a new data source for Ranger users.
+func NewRangerUserDataSource() datasource.DataSource {
+	return &RangerUserDataSource{}
+}
+
+// RangerUserDataSource defines the data source implementation.
+type RangerUserDataSource struct {
+	client *RangerClient
+}
+
+// RangerUserDataSourceModel describes the data source data model.
+type RangerUserDataSourceModel struct {
+	ID           types.String   `tfsdk:"id"`
+	Name         types.String   `tfsdk:"name"`
+	FirstName    types.String   `tfsdk:"first_name"`
+	LastName     types.String   `tfsdk:"last_name"`
+	EmailAddress types.String   `tfsdk:"email_address"`
+	Description  types.String   `tfsdk:"description"`
+	Status       types.String   `tfsdk:"status"`
+	UserSource   types.String   `tfsdk:"user_source"`
+	UserRoleList []types.String `tfsdk:"user_role_list"`
+	Groups       []types.String `tfsdk:"groups"`
+}
+
+// Metadata returns the data source type name.
+func (d *RangerUserDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user"
+}
+
+// Schema defines the schema for the data source.
+func (d *RangerUserDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Retrieve an existing Apache Ranger user, internal or synchronized by usersync. Reading a user that does not exist fails, so the data source can check that a principal exists",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The internal ID of the user in Apache Ranger",
+				Computed:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The login name of the user",
+				Required:            true,
+			},
+			"first_name": schema.StringAttribute{
+				MarkdownDescription: "The first name of the user",
+				Computed:            true,
+			},
+			"last_name": schema.StringAttribute{
+				MarkdownDescription: "The last name of the user",
+				Computed:            true,
+			},
+			"email_address": schema.StringAttribute{
+				MarkdownDescription: "The email address of the user",
+				Computed:            true,
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "A human-readable description of the user",
+				Computed:            true,
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "Whether the user may log in to Ranger Admin: `enabled` or `disabled`",
+				Computed:            true,
+			},
+			"user_source": schema.StringAttribute{
+				MarkdownDescription: "Where the user comes from: `internal` for users created in Ranger, `external` for users synchronized by usersync from LDAP/AD or Unix",
+				Computed:            true,
+			},
+			"user_role_list": schema.SetAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "The Ranger Admin roles of the user, such as `ROLE_USER` or `ROLE_SYS_ADMIN`",
+				Computed:            true,
+			},
+			"groups": schema.SetAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "The names of the groups the user is a member of",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *RangerUserDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*RangerClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *RangerClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+// Read reads the data source.
+func (d *RangerUserDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data RangerUserDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading Ranger user", map[string]interface{}{
+		"name": data.Name.ValueString(),
+	})
+
+	user, err := d.client.GetUserByName(ctx, data.Name.ValueString())
+	if ranger.IsNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Ranger User Not Found",
+			fmt.Sprintf("No user found with name %q", data.Name.ValueString()),
+		)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Ranger User",
+			fmt.Sprintf("Could not read user %q: %s", data.Name.ValueString(), err),
+		)
+		return
+	}
+
+	// Depending on the Ranger version, the group names are not returned
+	// along with their IDs
+	groups := user.GroupNameList
+	if len(groups) == 0 {
+		for _, id := range user.GroupIDList {
+			group, err := d.client.GetGroup(ctx, id)
+			if ranger.IsNotFound(err) {
+				continue
+			}
+			if err != nil {
+				resp.Diagnostics.AddError(
+					"Error Reading Ranger Group",
+					fmt.Sprintf("Could not read group ID %d of user %q: %s", id, user.Name, err),
+				)
+				return
+			}
+			groups = append(groups, group.Name)
+		}
+	}
+
+	data = convertUserToDataSourceModel(*user, groups)
+
+	// Set the state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// convertUserToDataSourceModel converts a Ranger user and the names of its
+// groups to the data source model.
+func convertUserToDataSourceModel(user ranger.User, groups []string) RangerUserDataSourceModel {
+	model := RangerUserDataSourceModel{
+		ID:           types.StringValue(fmt.Sprintf("%d", user.ID)),
+		Name:         types.StringValue(user.Name),
+		FirstName:    stringValueOrNull(user.FirstName),
+		LastName:     stringValueOrNull(user.LastName),
+		EmailAddress: stringValueOrNull(user.EmailAddress),
+		Description:  stringValueOrNull(user.Description),
+		Status:       types.StringValue(userStatusName(user.Status)),
+		UserSource:   types.StringValue(principalSourceName(user.UserSource)),
+		UserRoleList: []types.String{},
+		Groups:       []types.String{},
+	}
+
+	for _, role := range user.UserRoleList {
+		model.UserRoleList = append(model.UserRoleList, types.StringValue(role))
+	}
+
+	sort.Strings(groups)
+	for _, group := range groups {
+		model.Groups = append(model.Groups, types.StringValue(group))
+	}
+
+	return model
+}
@@ -0,0 +1,98 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-provider-scaffolding-framework/internal/ranger"
+)
+
+// testDataSourceRead reads d with only name configured, against a Ranger
+// server served by handler.
+func testDataSourceRead(t *testing.T, d datasource.DataSourceWithConfigure, name string, handler http.HandlerFunc) *datasource.ReadResponse {
+	t.Helper()
+	ctx := context.Background()
+
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	configureResp := &datasource.ConfigureResponse{}
+	d.Configure(ctx, datasource.ConfigureRequest{
+		ProviderData: &RangerClient{Client: ranger.NewClient(ranger.Config{Endpoint: server.URL})},
+	}, configureResp)
+	if configureResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected errors: %v", configureResp.Diagnostics)
+	}
+
+	schemaResp := &datasource.SchemaResponse{}
+	d.Schema(ctx, datasource.SchemaRequest{}, schemaResp)
+	objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+
+	config := map[string]tftypes.Value{}
+	for attribute, attrType := range objectType.AttributeTypes {
+		config[attribute] = tftypes.NewValue(attrType, nil)
+	}
+	if _, ok := objectType.AttributeTypes["name"]; ok {
+		config["name"] = tftypes.NewValue(tftypes.String, name)
+	}
+
+	req := datasource.ReadRequest{
+		Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, config)},
+	}
+	resp := &datasource.ReadResponse{
+		State: tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, nil)},
+	}
+	d.Read(ctx, req, resp)
+	return resp
+}
+
+func TestUserDataSourceRead(t *testing.T) {
+	resp := testDataSourceRead(t, &RangerUserDataSource{}, "svc_etl", func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/service/xusers/users/userName/svc_etl":
+			// Group names are not returned by every Ranger version
+			_, _ = w.Write([]byte(`{"id":5,"name":"svc_etl","status":1,"userSource":1,"userRoleList":["ROLE_USER"],"groupIdList":[3,4]}`))
+		case "/service/xusers/groups/3":
+			_, _ = w.Write([]byte(`{"id":3,"name":"etl"}`))
+		case "/service/xusers/groups/4":
+			_, _ = w.Write([]byte(`{"id":4,"name":"analysts"}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected errors: %v", resp.Diagnostics)
+	}
+
+	var data RangerUserDataSourceModel
+	resp.Diagnostics.Append(resp.State.Get(context.Background(), &data)...)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("could not read state: %v", resp.Diagnostics)
+	}
+	if data.ID.ValueString() != "5" || data.Status.ValueString() != "enabled" || data.UserSource.ValueString() != "external" {
+		t.Errorf("unexpected user: %+v", data)
+	}
+	if len(data.Groups) != 2 || data.Groups[0].ValueString() != "analysts" || data.Groups[1].ValueString() != "etl" {
+		t.Errorf("expected the groups to be resolved from their IDs, got %v", data.Groups)
+	}
+	if !data.FirstName.IsNull() {
+		t.Errorf("expected an empty first name to be null, got %v", data.FirstName)
+	}
+
+	// A missing user fails the read
+	resp = testDataSourceRead(t, &RangerUserDataSource{}, "alcie", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(`{"statusCode":1,"msgDesc":"User not found","messageList":[{"name":"DATA_NOT_FOUND"}]}`))
+	})
+	if !resp.Diagnostics.HasError() || resp.Diagnostics[0].Summary() != "Ranger User Not Found" {
+		t.Errorf("expected a not found error, got: %v", resp.Diagnostics)
+	}
+}
@@ -0,0 +1,503 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-scaffolding-framework/internal/ranger"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &rangerUserResource{}
+	_ resource.ResourceWithImportState    = &rangerUserResource{}
+	_ resource.ResourceWithValidateConfig = &rangerUserResource{}
+	_ resource.ResourceWithModifyPlan     = &rangerUserResource{}
+)
+
+// NewRangerUserResource is a helper function to simplify the provider implementation.
+func NewRangerUserResource() resource.Resource {
+	return &rangerUserResource{}
+}
+
+// rangerUserResource is the resource implementation.
+type rangerUserResource struct {
+	client *RangerClient
+}
+
+// RangerUserResourceModel maps the resource schema to Go objects.
+type RangerUserResourceModel struct {
+	ID              types.String   `tfsdk:"id"`
+	Name            types.String   `tfsdk:"name"`
+	FirstName       types.String   `tfsdk:"first_name"`
+	LastName        types.String   `tfsdk:"last_name"`
+	EmailAddress    types.String   `tfsdk:"email_address"`
+	Password        types.String   `tfsdk:"password"`
+	PasswordVersion types.Int64    `tfsdk:"password_version"`
+	Description     types.String   `tfsdk:"description"`
+	Status          types.String   `tfsdk:"status"`
+	UserSource      types.String   `tfsdk:"user_source"`
+	UserRoleList    []types.String `tfsdk:"user_role_list"`
+	GroupIDs        []types.String `tfsdk:"group_ids"`
+}
+
+// userStatuses maps the status values to Ranger's user status.
+var userStatuses = map[string]int64{
+	"enabled":  ranger.UserStatusEnabled,
+	"disabled": ranger.UserStatusDisabled,
+}
+
+// userRoles are the roles a Ranger user may have.
+var userRoles = []string{"ROLE_USER", "ROLE_SYS_ADMIN", "ROLE_KEY_ADMIN", "ROLE_ADMIN_AUDITOR", "ROLE_KEY_ADMIN_AUDITOR"}
+
+// Metadata returns the resource type name.
+func (r *rangerUserResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user"
+}
+
+// Schema defines the schema for the resource.
+func (r *rangerUserResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Apache Ranger internal user resource, for service accounts that are not synchronized from LDAP/AD. Users synchronized by usersync can be imported but not modified",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The internal ID of the user in Apache Ranger",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The login name of the user. Policies refer to the user by this name",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"first_name": schema.StringAttribute{
+				MarkdownDescription: "The first name of the user. Ranger uses `name` when not set",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"last_name": schema.StringAttribute{
+				MarkdownDescription: "The last name of the user",
+				Optional:            true,
+			},
+			"email_address": schema.StringAttribute{
+				MarkdownDescription: "The email address of the user",
+				Optional:            true,
+			},
+			"password": schema.StringAttribute{
+				MarkdownDescription: "The password of the user, required to create it. It is write-only and never stored in state, so it is only sent when the user is created or `password_version` changes. Requires Terraform 1.11 or later",
+				Optional:            true,
+				Sensitive:           true,
+				WriteOnly:           true,
+			},
+			"password_version": schema.Int64Attribute{
+				MarkdownDescription: "Change this value to update the password of an existing user to `password`",
+				Optional:            true,
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "A human-readable description of the user",
+				Optional:            true,
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "Whether the user can log in: `enabled` (default) or `disabled`",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("enabled"),
+			},
+			"user_source": schema.StringAttribute{
+				MarkdownDescription: "Where the user comes from: `internal` for users created in Ranger, `external` for users synchronized by usersync from LDAP/AD or Unix",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"user_role_list": schema.SetAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "The roles of the user in Ranger Admin: `ROLE_USER` (default), `ROLE_SYS_ADMIN`, `ROLE_KEY_ADMIN`, `ROLE_ADMIN_AUDITOR` or `ROLE_KEY_ADMIN_AUDITOR`",
+				Optional:            true,
+				Computed:            true,
+				Default:             setdefault.StaticValue(types.SetValueMust(types.StringType, []attr.Value{types.StringValue("ROLE_USER")})),
+			},
+			"group_ids": schema.SetAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "The IDs of the groups of the user. When not set, group memberships are left untouched, e.g. for `ranger_group_membership` to manage them",
+				Optional:            true,
+			},
+		},
+	}
+}
+
+// ValidateConfig checks the status and roles of the user.
+func (r *rangerUserResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var status types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("status"), &status)...)
+	if !status.IsNull() && !status.IsUnknown() {
+		if _, ok := userStatuses[status.ValueString()]; !ok {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("status"),
+				"Invalid User Status",
+				fmt.Sprintf("status must be %q or %q, got %q.", "enabled", "disabled", status.ValueString()),
+			)
+		}
+	}
+
+	var roles types.Set
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("user_role_list"), &roles)...)
+	for _, role := range knownStrings(roles) {
+		if !containsString(userRoles, role.ValueString()) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("user_role_list").AtSetValue(role),
+				"Invalid User Role",
+				fmt.Sprintf("Role %q is not a Ranger user role. Valid roles: %s.", role.ValueString(), quotedList(userRoles)),
+			)
+		}
+	}
+}
+
+// ModifyPlan requires a password for users to be created, and refuses to
+// change or delete users synchronized by usersync.
+func (r *rangerUserResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if !req.Plan.Raw.IsNull() {
+		var id types.String
+		resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("id"), &id)...)
+		if id.IsUnknown() {
+			var password types.String
+			resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("password"), &password)...)
+			if password.IsNull() {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("password"),
+					"Missing User Password",
+					"Ranger requires a password to create an internal user.",
+				)
+			}
+		}
+	}
+
+	if req.State.Raw.IsNull() || req.Plan.Raw.Equal(req.State.Raw) {
+		return
+	}
+
+	var state RangerUserResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(checkPrincipalModifiable("User", state.Name.ValueString(), state.UserSource)...)
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *rangerUserResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*RangerClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *RangerClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// Create creates a new Ranger user.
+func (r *rangerUserResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan RangerUserResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Write-only values are only available in the configuration
+	var password types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("password"), &password)...)
+
+	user, diags := convertModelToUser(plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	user.Password = password.ValueString()
+
+	createdUser, err := r.client.CreateUser(ctx, &user)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating Ranger User",
+			fmt.Sprintf("Could not create user %q: %s", user.Name, err),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(fmt.Sprintf("%d", createdUser.ID))
+	plan.UserSource = types.StringValue(principalSourceName(createdUser.UserSource))
+	if plan.FirstName.IsUnknown() {
+		plan.FirstName = stringValueOrNull(createdUser.FirstName)
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "Created Ranger user", map[string]interface{}{
+		"id":   createdUser.ID,
+		"name": createdUser.Name,
+	})
+}
+
+// Read reads the Ranger user from the API.
+func (r *rangerUserResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state RangerUserResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := parseInt64(state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Ranger User",
+			fmt.Sprintf("Could not parse user ID: %s", err),
+		)
+		return
+	}
+
+	user, err := r.client.GetUser(ctx, id)
+	if ranger.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Ranger User",
+			fmt.Sprintf("Could not read user ID %d: %s", id, err),
+		)
+		return
+	}
+
+	model := convertUserToModel(*user, state)
+
+	diags = resp.State.Set(ctx, model)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update updates an existing Ranger user.
+func (r *rangerUserResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state RangerUserResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	user, diags := convertModelToUser(plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := parseInt64(plan.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Ranger User",
+			fmt.Sprintf("Could not parse user ID: %s", err),
+		)
+		return
+	}
+	user.ID = id
+
+	current, err := r.client.GetUser(ctx, id)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Ranger User",
+			fmt.Sprintf("Could not read user ID %d: %s", id, err),
+		)
+		return
+	}
+
+	// The source is kept as Ranger has it, and as Ranger replaces the group
+	// memberships on update, unmanaged ones are sent back as they are
+	user.UserSource = current.UserSource
+	if plan.GroupIDs == nil {
+		user.GroupIDList = current.GroupIDList
+	}
+
+	// The password is only sent when asked to, as Ranger keeps the stored one otherwise
+	if !plan.PasswordVersion.Equal(state.PasswordVersion) {
+		var password types.String
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("password"), &password)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		user.Password = password.ValueString()
+	}
+
+	updatedUser, err := r.client.UpdateUser(ctx, id, &user)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Ranger User",
+			fmt.Sprintf("Could not update user ID %d: %s", id, err),
+		)
+		return
+	}
+
+	plan.UserSource = types.StringValue(principalSourceName(updatedUser.UserSource))
+	if plan.FirstName.IsUnknown() {
+		plan.FirstName = stringValueOrNull(updatedUser.FirstName)
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "Updated Ranger user", map[string]interface{}{
+		"id":   updatedUser.ID,
+		"name": updatedUser.Name,
+	})
+}
+
+// Delete deletes a Ranger user.
+func (r *rangerUserResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state RangerUserResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := parseInt64(state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting Ranger User",
+			fmt.Sprintf("Could not parse user ID: %s", err),
+		)
+		return
+	}
+
+	err = r.client.DeleteUser(ctx, id)
+	if err != nil && !ranger.IsNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Error Deleting Ranger User",
+			fmt.Sprintf("Could not delete user ID %d: %s", id, err),
+		)
+		return
+	}
+
+	tflog.Info(ctx, "Deleted Ranger user", map[string]interface{}{
+		"id": id,
+	})
+}
+
+// ImportState imports a Ranger user by name.
+func (r *rangerUserResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	user, err := r.client.GetUserByName(ctx, req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Importing Ranger User",
+			fmt.Sprintf("Could not find user %q: %s", req.ID, err),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), fmt.Sprintf("%d", user.ID))...)
+}
+
+// convertModelToUser converts a Terraform model to an internal Ranger user,
+// without its password. Updates keep the source Ranger has for the user.
+func convertModelToUser(model RangerUserResourceModel) (ranger.User, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	user := ranger.User{
+		Name:         model.Name.ValueString(),
+		FirstName:    model.FirstName.ValueString(),
+		LastName:     model.LastName.ValueString(),
+		EmailAddress: model.EmailAddress.ValueString(),
+		Description:  model.Description.ValueString(),
+		Status:       userStatuses[model.Status.ValueString()],
+		UserSource:   ranger.SourceInternal,
+		UserRoleList: valueStrings(model.UserRoleList),
+	}
+
+	for _, groupID := range model.GroupIDs {
+		id, err := parseInt64(groupID.ValueString())
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("group_ids").AtSetValue(groupID),
+				"Invalid Group ID",
+				fmt.Sprintf("Could not parse group ID %q: %s", groupID.ValueString(), err),
+			)
+			continue
+		}
+		user.GroupIDList = append(user.GroupIDList, id)
+	}
+
+	return user, diags
+}
+
+// convertUserToModel converts a Ranger user to a Terraform model. The prior
+// state provides the password version and tells whether group memberships
+// are managed by this resource.
+func convertUserToModel(user ranger.User, prior RangerUserResourceModel) RangerUserResourceModel {
+	model := RangerUserResourceModel{
+		ID:              types.StringValue(fmt.Sprintf("%d", user.ID)),
+		Name:            types.StringValue(user.Name),
+		FirstName:       stringValueOrNull(user.FirstName),
+		LastName:        stringValueOrNull(user.LastName),
+		EmailAddress:    stringValueOrNull(user.EmailAddress),
+		Password:        types.StringNull(),
+		PasswordVersion: prior.PasswordVersion,
+		Description:     stringValueOrNull(user.Description),
+		Status:          types.StringValue(userStatusName(user.Status)),
+		UserSource:      types.StringValue(principalSourceName(user.UserSource)),
+		UserRoleList:    stringValues(user.UserRoleList),
+	}
+
+	// Group memberships are only tracked when configured, so that importing
+	// a user leaves the ones usersync manages alone
+	if prior.GroupIDs == nil {
+		return model
+	}
+	model.GroupIDs = []types.String{}
+	for _, id := range user.GroupIDList {
+		model.GroupIDs = append(model.GroupIDs, types.StringValue(fmt.Sprintf("%d", id)))
+	}
+
+	return model
+}
+
+// userStatusName returns the status value of a Ranger user status.
+func userStatusName(status int64) string {
+	for name, value := range userStatuses {
+		if value == status {
+			return name
+		}
+	}
+	return fmt.Sprintf("%d", status)
+}
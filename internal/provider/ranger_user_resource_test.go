@@ -0,0 +1,127 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-scaffolding-framework/internal/ranger"
+)
+
+func TestConvertUserToModelGroups(t *testing.T) {
+	user := ranger.User{
+		ID:           12,
+		Name:         "svc_etl",
+		FirstName:    "svc_etl",
+		Password:     ranger.MaskedPassword,
+		Status:       ranger.UserStatusDisabled,
+		UserSource:   ranger.SourceExternal,
+		UserRoleList: []string{"ROLE_USER"},
+		GroupIDList:  []int64{3, 5},
+	}
+
+	// Import: group memberships are left unmanaged
+	model := convertUserToModel(user, RangerUserResourceModel{})
+	if model.GroupIDs != nil {
+		t.Errorf("expected group IDs to be null on import, got %v", model.GroupIDs)
+	}
+	if !model.Password.IsNull() || model.Status.ValueString() != "disabled" || model.UserSource.ValueString() != "external" {
+		t.Errorf("unexpected password, status or source: %s, %s, %s", model.Password, model.Status, model.UserSource)
+	}
+
+	// Refresh: unmanaged group memberships stay untracked
+	prior := RangerUserResourceModel{
+		Name:            types.StringValue("svc_etl"),
+		PasswordVersion: types.Int64Value(2),
+	}
+	model = convertUserToModel(user, prior)
+	if model.GroupIDs != nil {
+		t.Errorf("expected unmanaged group IDs to be null, got %v", model.GroupIDs)
+	}
+	if model.PasswordVersion.ValueInt64() != 2 {
+		t.Errorf("password version not preserved: %s", model.PasswordVersion)
+	}
+
+	// Refresh: managed group memberships are tracked, even when empty
+	prior.GroupIDs = []types.String{types.StringValue("3")}
+	model = convertUserToModel(user, prior)
+	if len(model.GroupIDs) != 2 || model.GroupIDs[1].ValueString() != "5" {
+		t.Errorf("unexpected managed group IDs: %v", model.GroupIDs)
+	}
+
+	user.GroupIDList = nil
+	model = convertUserToModel(user, prior)
+	if model.GroupIDs == nil || len(model.GroupIDs) != 0 {
+		t.Errorf("expected managed group IDs to be empty, got %v", model.GroupIDs)
+	}
+}
+
+func TestUserModifyPlan(t *testing.T) {
+	ctx := context.Background()
+	r := NewRangerUserResource().(*rangerUserResource)
+	model := RangerUserResourceModel{
+		ID:              types.StringValue("12"),
+		Name:            types.StringValue("svc_etl"),
+		FirstName:       types.StringValue("svc_etl"),
+		LastName:        types.StringNull(),
+		EmailAddress:    types.StringNull(),
+		Password:        types.StringNull(),
+		PasswordVersion: types.Int64Null(),
+		Description:     types.StringNull(),
+		Status:          types.StringValue("enabled"),
+		UserSource:      types.StringValue("internal"),
+		UserRoleList:    []types.String{types.StringValue("ROLE_USER")},
+	}
+
+	modifyPlan := func(state, plan RangerUserResourceModel, create bool) *resource.ModifyPlanResponse {
+		planned := testResourcePlan(t, r, plan)
+		prior := testResourcePlan(t, r, state)
+		req := resource.ModifyPlanRequest{
+			Config: tfsdk.Config{Schema: planned.Schema, Raw: planned.Raw},
+			Plan:   planned,
+			State:  tfsdk.State{Schema: prior.Schema, Raw: prior.Raw},
+		}
+		if create {
+			req.State.RemoveResource(ctx)
+		}
+		resp := &resource.ModifyPlanResponse{Plan: req.Plan}
+		r.ModifyPlan(ctx, req, resp)
+		return resp
+	}
+
+	// Create: a password is required
+	created := model
+	created.ID = types.StringUnknown()
+	created.UserSource = types.StringUnknown()
+	resp := modifyPlan(model, created, true)
+	if resp.Diagnostics.ErrorsCount() != 1 || resp.Diagnostics[0].Summary() != "Missing User Password" {
+		t.Errorf("expected a missing password error, got: %v", resp.Diagnostics)
+	}
+	created.Password = types.StringValue("Secret123")
+	if resp := modifyPlan(model, created, true); resp.Diagnostics.HasError() {
+		t.Errorf("unexpected errors: %v", resp.Diagnostics)
+	}
+
+	// Update: external users cannot be changed
+	changed := model
+	changed.Description = types.StringValue("ETL service account")
+	if resp := modifyPlan(model, changed, false); resp.Diagnostics.HasError() {
+		t.Errorf("unexpected errors for an internal user: %v", resp.Diagnostics)
+	}
+
+	external := model
+	external.UserSource = types.StringValue("external")
+	if resp := modifyPlan(external, external, false); resp.Diagnostics.HasError() {
+		t.Errorf("unexpected errors for an unchanged external user: %v", resp.Diagnostics)
+	}
+	changed.UserSource = external.UserSource
+	resp = modifyPlan(external, changed, false)
+	if resp.Diagnostics.ErrorsCount() != 1 || resp.Diagnostics[0].Summary() != "External Ranger User" {
+		t.Errorf("expected an external user error, got: %v", resp.Diagnostics)
+	}
+}
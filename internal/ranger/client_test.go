@@ -0,0 +1,354 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ranger
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func newTestClient(t *testing.T, handler http.HandlerFunc) *Client {
+	t.Helper()
+
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	return NewClient(Config{
+		Endpoint: server.URL + "/",
+		Username: "admin",
+		Password: "secret",
+	})
+}
+
+func TestClientGetPolicy(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/service/public/v2/api/policy/42" {
+			t.Errorf("unexpected path %q", r.URL.Path)
+		}
+		if user, pass, ok := r.BasicAuth(); !ok || user != "admin" || pass != "secret" {
+			t.Errorf("unexpected credentials %q/%q", user, pass)
+		}
+		_, _ = w.Write([]byte(`{"id":42,"name":"p1","service":"hive","isEnabled":true}`))
+	})
+
+	policy, err := client.GetPolicy(context.Background(), 42)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if policy.ID != 42 || policy.Name != "p1" || !policy.IsEnabled {
+		t.Errorf("unexpected policy: %+v", policy)
+	}
+}
+
+func TestClientAPIError(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(`{"statusCode":1,"msgDesc":"(0) Validation failure: error code[3010]","messageList":[{"name":"INVALID_INPUT_DATA","message":"Invalid access type: selct"}]}`))
+	})
+
+	_, err := client.CreatePolicy(context.Background(), &Policy{Name: "p1"})
+
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("expected *APIError, got %T: %v", err, err)
+	}
+	if apiErr.StatusCode != http.StatusBadRequest {
+		t.Errorf("unexpected status code %d", apiErr.StatusCode)
+	}
+	if !strings.Contains(err.Error(), "Invalid access type: selct") {
+		t.Errorf("error does not include message list: %s", err)
+	}
+	if IsNotFound(err) {
+		t.Error("IsNotFound returned true for a 400 response")
+	}
+}
+
+func TestClientDataNotFound(t *testing.T) {
+	tests := map[string]struct {
+		status   int
+		notFound bool
+	}{
+		"bad request":  {status: http.StatusBadRequest, notFound: true},
+		"server error": {status: http.StatusInternalServerError, notFound: false},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(test.status)
+				_, _ = w.Write([]byte(`{"statusCode":1,"msgDesc":"User not found","messageList":[{"name":"DATA_NOT_FOUND","message":"User not found"}]}`))
+			})
+
+			_, err := client.GetUserByName(context.Background(), "alcie")
+			if err == nil {
+				t.Fatal("expected an error")
+			}
+			if IsNotFound(err) != test.notFound {
+				t.Errorf("IsNotFound returned %t for a %d DATA_NOT_FOUND response", !test.notFound, test.status)
+			}
+		})
+	}
+}
+
+func TestClientGetPolicyByName(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.EscapedPath() != "/service/public/v2/api/service/hive/policy/sales%20data" {
+			t.Errorf("unexpected path %q", r.URL.EscapedPath())
+		}
+		if got := r.URL.Query().Get("zoneName"); got != "finance" {
+			t.Errorf("unexpected zoneName %q", got)
+		}
+		_, _ = w.Write([]byte(`{"id":7,"name":"sales data","service":"hive","zoneName":"finance"}`))
+	})
+
+	policy, err := client.GetPolicyByName(context.Background(), "hive", "sales data", "finance")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if policy.ID != 7 || policy.ZoneName != "finance" {
+		t.Errorf("unexpected policy: %+v", policy)
+	}
+}
+
+func TestClientGetPolicyByNameNotFound(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.RawQuery != "" {
+			t.Errorf("unexpected query %q", r.URL.RawQuery)
+		}
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"statusCode":1,"msgDesc":"Not found"}`))
+	})
+
+	_, err := client.GetPolicyByName(context.Background(), "hive", "sales", "")
+	if !IsNotFound(err) {
+		t.Fatalf("expected not found error, got %v", err)
+	}
+}
+
+func TestClientListPoliciesPagination(t *testing.T) {
+	for name, test := range map[string]struct {
+		maxPageSize int
+		requests    int
+	}{
+		"default page size": {maxPageSize: policyPageSize, requests: 3},
+		// A server configured with a lower maximum returns smaller pages
+		"lower server maximum": {maxPageSize: 100, requests: 4},
+	} {
+		t.Run(name, func(t *testing.T) {
+			testClientListPoliciesPagination(t, test.maxPageSize, test.requests)
+		})
+	}
+}
+
+func testClientListPoliciesPagination(t *testing.T, maxPageSize, expectedRequests int) {
+	const total = policyPageSize + 50
+
+	var requests int
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		query := r.URL.Query()
+		if got := query.Get("group"); got != "contractors" {
+			t.Errorf("filter was not passed on, got group %q", got)
+		}
+
+		start, _ := strconv.Atoi(query.Get("startIndex"))
+		size, _ := strconv.Atoi(query.Get("pageSize"))
+		if size > maxPageSize {
+			size = maxPageSize
+		}
+		end := start + size
+		if end > total {
+			end = total
+		}
+		if start > end {
+			start = end
+		}
+
+		page := make([]Policy, 0, end-start)
+		for id := start; id < end; id++ {
+			page = append(page, Policy{ID: int64(id)})
+		}
+		_ = json.NewEncoder(w).Encode(page)
+	})
+
+	policies, err := client.ListPolicies(context.Background(), "", url.Values{"group": {"contractors"}})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if len(policies) != total || policies[total-1].ID != total-1 {
+		t.Errorf("expected %d policies in order, got %d", total, len(policies))
+	}
+	if requests != expectedRequests {
+		t.Errorf("expected %d requests, got %d", expectedRequests, requests)
+	}
+}
+
+func TestClientListPoliciesIgnoredPaging(t *testing.T) {
+	var requests int
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		// The same page is returned whatever the startIndex
+		_, _ = w.Write([]byte(`[{"id":1,"name":"sales"},{"id":2,"name":"finance"}]`))
+	})
+
+	policies, err := client.ListPolicies(context.Background(), "hive", nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if len(policies) != 2 || requests != 2 {
+		t.Errorf("expected 2 policies from 2 requests, got %d from %d", len(policies), requests)
+	}
+}
+
+func TestClientListPoliciesMaxPages(t *testing.T) {
+	var requests int
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		start, _ := strconv.Atoi(r.URL.Query().Get("startIndex"))
+		_ = json.NewEncoder(w).Encode([]Policy{{ID: int64(start)}})
+	})
+
+	if _, err := client.ListPolicies(context.Background(), "", nil); err == nil {
+		t.Fatal("expected an error for a listing that does not end")
+	}
+	if requests != maxPolicyPages {
+		t.Errorf("expected %d requests, got %d", maxPolicyPages, requests)
+	}
+}
+
+func TestClientUpdateUserKeepsPassword(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]interface{}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Fatalf("could not decode request body: %s", err)
+		}
+		if _, ok := body["password"]; ok {
+			t.Errorf("password was sent although it is not changed")
+		}
+		_, _ = w.Write([]byte(`{"id":12,"name":"svc_etl","password":"*****","status":1}`))
+	})
+
+	user, err := client.UpdateUser(context.Background(), 12, &User{Name: "svc_etl", Status: UserStatusEnabled})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if user.ID != 12 || user.Status != UserStatusEnabled {
+		t.Errorf("unexpected user: %+v", user)
+	}
+}
+
+func TestClientDeleteUser(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete || r.URL.Path != "/service/xusers/secure/users/id/12" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		if got := r.URL.Query().Get("forceDelete"); got != "true" {
+			t.Errorf("expected a forced delete, got forceDelete=%q", got)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	if err := client.DeleteUser(context.Background(), 12); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+}
+
+func TestClientGetGroupUsers(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.EscapedPath() != "/service/xusers/groupusers/groupName/data%20engineers" {
+			t.Errorf("unexpected path %q", r.URL.EscapedPath())
+		}
+		_, _ = w.Write([]byte(`{"xgroupInfo":{"id":4,"name":"data engineers","groupSource":1},"xuserInfo":[{"id":12,"name":"alice"},{"id":13,"name":"bob"}]}`))
+	})
+
+	groupUsers, err := client.GetGroupUsers(context.Background(), "data engineers")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if groupUsers.Group.ID != 4 || groupUsers.Group.GroupSource != SourceExternal {
+		t.Errorf("unexpected group: %+v", groupUsers.Group)
+	}
+	if len(groupUsers.Users) != 2 || groupUsers.Users[1].Name != "bob" {
+		t.Errorf("unexpected users: %+v", groupUsers.Users)
+	}
+}
+
+func TestClientTagDefs(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method + " " + r.URL.EscapedPath() {
+		case "GET /service/tags/tagdef/4":
+			_, _ = w.Write([]byte(`{"id":4,"guid":"2f6c","isEnabled":true,"version":3,"name":"PII","attributeDefs":[{"name":"expiry_date","type":"date"}]}`))
+		case "GET /service/tags/tagdef/name/PII%2Flegacy":
+			_, _ = w.Write([]byte(`{"id":5,"name":"PII/legacy","attributeDefs":[]}`))
+		case "POST /service/tags/tagdef":
+			if got := r.URL.Query().Get("updateIfExists"); got != "false" {
+				t.Errorf("expected updateIfExists=false, got %q", got)
+			}
+			var tagDef TagDef
+			if err := json.NewDecoder(r.Body).Decode(&tagDef); err != nil {
+				t.Fatalf("could not decode request: %s", err)
+			}
+			tagDef.ID = 6
+			_ = json.NewEncoder(w).Encode(tagDef)
+		case "PUT /service/tags/tagdef/4":
+			var body map[string]interface{}
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+				t.Fatalf("could not decode request: %s", err)
+			}
+			if body["guid"] != "2f6c" || body["version"] != float64(3) {
+				t.Errorf("expected the guid and version to be sent back, got: %v", body)
+			}
+			_ = json.NewEncoder(w).Encode(body)
+		case "DELETE /service/tags/tagdef/4":
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+	ctx := context.Background()
+
+	tagDef, err := client.GetTagDef(ctx, 4)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if tagDef.GUID != "2f6c" || len(tagDef.AttributeDefs) != 1 || tagDef.AttributeDefs[0].Type != "date" {
+		t.Errorf("unexpected tag definition: %+v", tagDef)
+	}
+
+	byName, err := client.GetTagDefByName(ctx, "PII/legacy")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if byName.ID != 5 {
+		t.Errorf("unexpected tag definition: %+v", byName)
+	}
+
+	created, err := client.CreateTagDef(ctx, &TagDef{Name: "RESTRICTED", IsEnabled: true, AttributeDefs: []TagAttributeDef{}})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if created.ID != 6 || created.Name != "RESTRICTED" {
+		t.Errorf("unexpected tag definition: %+v", created)
+	}
+
+	tagDef.Source = "Atlas"
+	if _, err := client.UpdateTagDef(ctx, 4, tagDef); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if err := client.DeleteTagDef(ctx, 4); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if _, err := client.GetTagDef(ctx, 7); !IsNotFound(err) {
+		t.Errorf("expected a not found error, got: %v", err)
+	}
+}
@@ -0,0 +1,102 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ranger
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strings"
+)
+
+// PolicyDocument is a policy as raw JSON, keyed by top-level field. Unlike
+// Policy it keeps every field Ranger returns, including the ones this package
+// does not model (guid, version, createdBy, additionalResources, ...), so it
+// can be sent back without losing them.
+type PolicyDocument map[string]json.RawMessage
+
+// policyFields lists the JSON keys of the fields modeled by Policy.
+var policyFields = jsonFieldNames(reflect.TypeOf(Policy{}))
+
+// GetPolicyDocument retrieves a policy by its ID as a raw JSON document.
+func (c *Client) GetPolicyDocument(ctx context.Context, id int64) (PolicyDocument, error) {
+	var doc PolicyDocument
+	if err := c.do(ctx, http.MethodGet, fmt.Sprintf("%s/%d", policyAPIPath, id), nil, nil, &doc); err != nil {
+		return nil, err
+	}
+	return doc, nil
+}
+
+// UpdatePolicyDocument replaces the policy with the given ID with a raw JSON
+// document and returns it as stored by Ranger.
+func (c *Client) UpdatePolicyDocument(ctx context.Context, id int64, doc PolicyDocument) (*Policy, error) {
+	var updated Policy
+	if err := c.do(ctx, http.MethodPut, fmt.Sprintf("%s/%d", policyAPIPath, id), nil, doc, &updated); err != nil {
+		return nil, err
+	}
+	return &updated, nil
+}
+
+// Version returns the version of the policy, which Ranger increments on every
+// update. It returns 0 when the document has no version.
+func (d PolicyDocument) Version() (int64, error) {
+	raw, ok := d["version"]
+	if !ok {
+		return 0, nil
+	}
+
+	var version int64
+	if err := json.Unmarshal(raw, &version); err != nil {
+		return 0, fmt.Errorf("could not decode policy version: %w", err)
+	}
+	return version, nil
+}
+
+// Merge overlays the fields modeled by Policy onto the document. Modeled
+// fields that policy leaves empty are removed from the document, fields
+// listed in skip are left as they are, and unmodeled fields are kept.
+func (d PolicyDocument) Merge(policy *Policy, skip ...string) error {
+	encoded, err := json.Marshal(policy)
+	if err != nil {
+		return fmt.Errorf("could not marshal policy: %w", err)
+	}
+
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(encoded, &fields); err != nil {
+		return fmt.Errorf("could not unmarshal policy: %w", err)
+	}
+
+	skipped := make(map[string]bool, len(skip))
+	for _, key := range skip {
+		skipped[key] = true
+	}
+
+	for _, key := range policyFields {
+		if skipped[key] {
+			continue
+		}
+		if value, ok := fields[key]; ok {
+			d[key] = value
+		} else {
+			delete(d, key)
+		}
+	}
+
+	return nil
+}
+
+// jsonFieldNames returns the JSON keys of the exported fields of a struct type.
+func jsonFieldNames(t reflect.Type) []string {
+	names := make([]string, 0, t.NumField())
+	for i := 0; i < t.NumField(); i++ {
+		name, _, _ := strings.Cut(t.Field(i).Tag.Get("json"), ",")
+		if name == "" || name == "-" {
+			continue
+		}
+		names = append(names, name)
+	}
+	return names
+}
@@ -0,0 +1,59 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ranger
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestPolicyDocumentMerge(t *testing.T) {
+	var doc PolicyDocument
+	err := json.Unmarshal([]byte(`{
+		"id": 12,
+		"guid": "0b4f4c3e-7f55-4b3c-8d43-6d1c3f1f0a2e",
+		"version": 4,
+		"createdBy": "Admin",
+		"name": "old_name",
+		"service": "hive",
+		"description": "stale description",
+		"policyLabels": ["owned-by-another-team"],
+		"denyPolicyItems": [{"users": ["bob"], "accesses": [{"type": "drop", "isAllowed": true}]}],
+		"additionalResources": [{"database": {"values": ["archive"]}}]
+	}`), &doc)
+	if err != nil {
+		t.Fatalf("could not unmarshal document: %s", err)
+	}
+
+	version, err := doc.Version()
+	if err != nil || version != 4 {
+		t.Fatalf("unexpected version %d: %v", version, err)
+	}
+
+	policy := &Policy{
+		ID:        12,
+		Version:   version,
+		Name:      "new_name",
+		Service:   "hive",
+		IsEnabled: true,
+		Resources: map[string]PolicyResources{"database": {Values: []string{"sales"}}},
+	}
+	if err := doc.Merge(policy, "policyLabels"); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	for _, key := range []string{"guid", "version", "createdBy", "additionalResources", "policyLabels"} {
+		if _, ok := doc[key]; !ok {
+			t.Errorf("unmanaged field %q was dropped", key)
+		}
+	}
+	for _, key := range []string{"description", "denyPolicyItems"} {
+		if _, ok := doc[key]; ok {
+			t.Errorf("managed field %q cleared in the plan was kept", key)
+		}
+	}
+	if string(doc["name"]) != `"new_name"` || string(doc["isEnabled"]) != "true" {
+		t.Errorf("managed fields were not overlaid: name=%s isEnabled=%s", doc["name"], doc["isEnabled"])
+	}
+}
@@ -0,0 +1,107 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ranger
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+)
+
+// ErrNotFound is returned when a lookup by name finds no matching object.
+var ErrNotFound = errors.New("not found")
+
+// APIError is returned when the Ranger API responds with a non-2xx status code.
+// It keeps the VXResponse body Ranger sends back so callers can surface the
+// server-side reason to the user.
+type APIError struct {
+	StatusCode  int       `json:"-"`
+	Method      string    `json:"-"`
+	Path        string    `json:"-"`
+	MsgDesc     string    `json:"msgDesc"`
+	MessageList []Message `json:"messageList"`
+	// Body is the raw response body, kept when it could not be decoded.
+	Body string `json:"-"`
+}
+
+// Message is a single entry of the messageList in a Ranger error response.
+type Message struct {
+	Name      string `json:"name"`
+	RbKey     string `json:"rbKey"`
+	Message   string `json:"message"`
+	ObjectID  int64  `json:"objectId"`
+	FieldName string `json:"fieldName"`
+}
+
+// Error implements the error interface.
+func (e *APIError) Error() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "%s %s returned status %d", e.Method, e.Path, e.StatusCode)
+
+	switch {
+	case e.MsgDesc != "":
+		fmt.Fprintf(&b, ": %s", e.MsgDesc)
+	case e.Body != "":
+		fmt.Fprintf(&b, ": %s", e.Body)
+	}
+
+	for _, msg := range e.MessageList {
+		if msg.Message == "" || msg.Message == e.MsgDesc {
+			continue
+		}
+		fmt.Fprintf(&b, "; %s", msg.Message)
+	}
+
+	return b.String()
+}
+
+// newAPIError builds an APIError from an unsuccessful response.
+func newAPIError(method, path string, response *http.Response) *APIError {
+	apiErr := &APIError{
+		StatusCode: response.StatusCode,
+		Method:     method,
+		Path:       path,
+	}
+
+	body, err := io.ReadAll(io.LimitReader(response.Body, 64*1024))
+	if err != nil || len(body) == 0 {
+		return apiErr
+	}
+
+	if err := json.Unmarshal(body, apiErr); err != nil || (apiErr.MsgDesc == "" && len(apiErr.MessageList) == 0) {
+		apiErr.Body = strings.TrimSpace(string(body))
+	}
+
+	return apiErr
+}
+
+// IsNotFound reports whether err indicates that the requested object does not
+// exist. The xusers API answers lookups of unknown users and groups with a
+// 400 response carrying a DATA_NOT_FOUND message rather than a 404.
+func IsNotFound(err error) bool {
+	if errors.Is(err, ErrNotFound) {
+		return true
+	}
+
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		return false
+	}
+	if apiErr.StatusCode == http.StatusNotFound {
+		return true
+	}
+	if apiErr.StatusCode != http.StatusBadRequest {
+		return false
+	}
+
+	for _, msg := range apiErr.MessageList {
+		if msg.Name == "DATA_NOT_FOUND" {
+			return true
+		}
+	}
+	return false
+}
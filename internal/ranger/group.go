@@ -0,0 +1,107 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ranger
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// Group visibilities. Hidden groups are not offered in the Ranger Admin UI.
+const (
+	GroupHidden  int64 = 0
+	GroupVisible int64 = 1
+)
+
+// Group represents the Apache Ranger group (VXGroup) JSON structure.
+type Group struct {
+	ID          int64  `json:"id,omitempty"`
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	GroupSource int64  `json:"groupSource"`
+	IsVisible   int64  `json:"isVisible,omitempty"`
+	SyncSource  string `json:"syncSource,omitempty"`
+}
+
+// GroupUsers is a group together with its member users (VXGroupUserInfo).
+type GroupUsers struct {
+	Group Group  `json:"xgroupInfo"`
+	Users []User `json:"xuserInfo"`
+}
+
+// groupUser links a user to a group (VXGroupUser).
+type groupUser struct {
+	Name          string `json:"name"`
+	ParentGroupID int64  `json:"parentGroupId"`
+	UserID        int64  `json:"userId"`
+}
+
+// GetGroup retrieves a group by its ID.
+func (c *Client) GetGroup(ctx context.Context, id int64) (*Group, error) {
+	var group Group
+	if err := c.do(ctx, http.MethodGet, fmt.Sprintf("%s/groups/%d", xUsersAPIPath, id), nil, nil, &group); err != nil {
+		return nil, err
+	}
+	return &group, nil
+}
+
+// GetGroupByName retrieves a group by its name.
+func (c *Client) GetGroupByName(ctx context.Context, name string) (*Group, error) {
+	var group Group
+	if err := c.do(ctx, http.MethodGet, fmt.Sprintf("%s/groups/groupName/%s", xUsersAPIPath, url.PathEscape(name)), nil, nil, &group); err != nil {
+		return nil, err
+	}
+	return &group, nil
+}
+
+// CreateGroup creates a group and returns it as stored by Ranger.
+func (c *Client) CreateGroup(ctx context.Context, group *Group) (*Group, error) {
+	var created Group
+	if err := c.do(ctx, http.MethodPost, xUsersAPIPath+"/secure/groups", nil, group, &created); err != nil {
+		return nil, err
+	}
+	return &created, nil
+}
+
+// UpdateGroup replaces the group with the given ID and returns it as stored by Ranger.
+func (c *Client) UpdateGroup(ctx context.Context, id int64, group *Group) (*Group, error) {
+	var updated Group
+	if err := c.do(ctx, http.MethodPut, fmt.Sprintf("%s/secure/groups/%d", xUsersAPIPath, id), nil, group, &updated); err != nil {
+		return nil, err
+	}
+	return &updated, nil
+}
+
+// DeleteGroup deletes the group with the given ID. Without forceDelete
+// Ranger only hides the group, keeping the name taken.
+func (c *Client) DeleteGroup(ctx context.Context, id int64) error {
+	query := url.Values{"forceDelete": []string{"true"}}
+	return c.do(ctx, http.MethodDelete, fmt.Sprintf("%s/secure/groups/id/%d", xUsersAPIPath, id), query, nil, nil)
+}
+
+// GetGroupUsers retrieves a group by its name together with its member users.
+func (c *Client) GetGroupUsers(ctx context.Context, groupName string) (*GroupUsers, error) {
+	var groupUsers GroupUsers
+	if err := c.do(ctx, http.MethodGet, fmt.Sprintf("%s/groupusers/groupName/%s", xUsersAPIPath, url.PathEscape(groupName)), nil, nil, &groupUsers); err != nil {
+		return nil, err
+	}
+	return &groupUsers, nil
+}
+
+// AddGroupUser makes the user with the given ID a member of the group.
+func (c *Client) AddGroupUser(ctx context.Context, group *Group, userID int64) error {
+	body := groupUser{
+		Name:          group.Name,
+		ParentGroupID: group.ID,
+		UserID:        userID,
+	}
+	return c.do(ctx, http.MethodPost, xUsersAPIPath+"/groupusers", nil, body, nil)
+}
+
+// RemoveGroupUser removes the named user from the named group.
+func (c *Client) RemoveGroupUser(ctx context.Context, groupName, userName string) error {
+	return c.do(ctx, http.MethodDelete, fmt.Sprintf("%s/group/%s/user/%s", xUsersAPIPath, url.PathEscape(groupName), url.PathEscape(userName)), nil, nil, nil)
+}
@@ -0,0 +1,281 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ranger
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+const policyAPIPath = "/service/public/v2/api/policy"
+
+// Policy types supported by Ranger.
+const (
+	PolicyTypeAccess    int64 = 0
+	PolicyTypeDataMask  int64 = 1
+	PolicyTypeRowFilter int64 = 2
+)
+
+// Policy priorities supported by Ranger. Override policies take precedence
+// over normal policies when both match a request.
+const (
+	PolicyPriorityNormal   int64 = 0
+	PolicyPriorityOverride int64 = 1
+)
+
+// Policy represents the Apache Ranger policy JSON structure.
+type Policy struct {
+	ID                   int64                      `json:"id,omitempty"`
+	Version              int64                      `json:"version,omitempty"`
+	Name                 string                     `json:"name"`
+	Service              string                     `json:"service"`
+	Description          string                     `json:"description,omitempty"`
+	IsEnabled            bool                       `json:"isEnabled"`
+	IsAuditEnabled       bool                       `json:"isAuditEnabled"`
+	Resources            map[string]PolicyResources `json:"resources"`
+	PolicyItems          []PolicyItem               `json:"policyItems,omitempty"`
+	DenyPolicyItems      []PolicyItem               `json:"denyPolicyItems,omitempty"`
+	AllowExceptions      []PolicyItem               `json:"allowExceptions,omitempty"`
+	DenyExceptions       []PolicyItem               `json:"denyExceptions,omitempty"`
+	DataMaskPolicyItems  []DataMaskPolicyItem       `json:"dataMaskPolicyItems,omitempty"`
+	RowFilterPolicyItems []RowFilterPolicyItem      `json:"rowFilterPolicyItems,omitempty"`
+	ValiditySchedules    []ValiditySchedule         `json:"validitySchedules,omitempty"`
+	PolicyType           int64                      `json:"policyType"`
+	PolicyPriority       int64                      `json:"policyPriority"`
+	PolicyLabels         []string                   `json:"policyLabels,omitempty"`
+	ZoneName             string                     `json:"zoneName,omitempty"`
+	IsDenyAllElse        bool                       `json:"isDenyAllElse"`
+	Options              map[string]interface{}     `json:"options,omitempty"`
+	ServiceType          string                     `json:"serviceType,omitempty"`
+}
+
+// PolicyResources represents a resource in the Ranger policy JSON.
+type PolicyResources struct {
+	Values      []string `json:"values"`
+	IsExclude   bool     `json:"isExcludes,omitempty"`
+	IsRecursive bool     `json:"isRecursive,omitempty"`
+}
+
+// PolicyItem represents the policy items in the Ranger policy JSON.
+type PolicyItem struct {
+	Users         []string              `json:"users,omitempty"`
+	Groups        []string              `json:"groups,omitempty"`
+	Roles         []string              `json:"roles,omitempty"`
+	Accesses      []Access              `json:"accesses"`
+	DelegateAdmin bool                  `json:"delegateAdmin"`
+	Conditions    []PolicyItemCondition `json:"conditions,omitempty"`
+}
+
+// PolicyItemCondition restricts a policy item to requests matching a
+// condition defined by the service-def (ip-range, _expression, ...). Ranger
+// only stores string values; anything else is kept aside in InvalidValues
+// when decoding, so that the policy can still be read and the condition
+// reported.
+type PolicyItemCondition struct {
+	Type   string   `json:"type"`
+	Values []string `json:"values"`
+	// InvalidValues holds the JSON encoding of the values that are not strings.
+	InvalidValues []string `json:"-"`
+}
+
+// UnmarshalJSON decodes a condition, setting values that are not strings
+// aside rather than failing to decode the whole policy.
+func (c *PolicyItemCondition) UnmarshalJSON(data []byte) error {
+	var raw struct {
+		Type   string            `json:"type"`
+		Values []json.RawMessage `json:"values"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	c.Type = raw.Type
+	c.Values = nil
+	c.InvalidValues = nil
+	if raw.Values != nil {
+		c.Values = make([]string, 0, len(raw.Values))
+	}
+
+	for _, value := range raw.Values {
+		var s string
+		if string(value) == "null" || json.Unmarshal(value, &s) != nil {
+			c.InvalidValues = append(c.InvalidValues, string(value))
+			continue
+		}
+		c.Values = append(c.Values, s)
+	}
+
+	return nil
+}
+
+// DataMaskPolicyItem represents a data masking rule in the Ranger policy JSON.
+type DataMaskPolicyItem struct {
+	PolicyItem
+	DataMaskInfo DataMaskInfo `json:"dataMaskInfo"`
+}
+
+// DataMaskInfo describes how a data masking rule transforms the column value.
+type DataMaskInfo struct {
+	DataMaskType  string `json:"dataMaskType"`
+	ConditionExpr string `json:"conditionExpr,omitempty"`
+	ValueExpr     string `json:"valueExpr,omitempty"`
+}
+
+// RowFilterPolicyItem represents a row-level filter rule in the Ranger policy JSON.
+type RowFilterPolicyItem struct {
+	PolicyItem
+	RowFilterInfo RowFilterInfo `json:"rowFilterInfo"`
+}
+
+// RowFilterInfo holds the filter expression applied to the rows of a table.
+type RowFilterInfo struct {
+	FilterExpr string `json:"filterExpr"`
+}
+
+// TimeFormat is the layout Ranger uses for validity schedule timestamps.
+const TimeFormat = "2006/01/02 15:04:05"
+
+// ValiditySchedule limits the time period during which a policy is enforced.
+type ValiditySchedule struct {
+	StartTime   string               `json:"startTime,omitempty"`
+	EndTime     string               `json:"endTime,omitempty"`
+	TimeZone    string               `json:"timeZone,omitempty"`
+	Recurrences []ValidityRecurrence `json:"recurrences,omitempty"`
+}
+
+// ValidityRecurrence is a cron-like schedule together with the length of
+// each window it opens.
+type ValidityRecurrence struct {
+	Schedule RecurrenceSchedule `json:"schedule"`
+	Interval ValidityInterval   `json:"interval"`
+}
+
+// RecurrenceSchedule holds the cron-like fields of a recurrence.
+type RecurrenceSchedule struct {
+	Minute     string `json:"minute"`
+	Hour       string `json:"hour"`
+	DayOfMonth string `json:"dayOfMonth"`
+	DayOfWeek  string `json:"dayOfWeek"`
+	Month      string `json:"month"`
+	Year       string `json:"year"`
+}
+
+// ValidityInterval is the length of a recurrence window.
+type ValidityInterval struct {
+	Days    int64 `json:"days"`
+	Hours   int64 `json:"hours"`
+	Minutes int64 `json:"minutes"`
+}
+
+// Access represents a permission in the Ranger policy JSON.
+type Access struct {
+	Type      string `json:"type"`
+	IsAllowed bool   `json:"isAllowed"`
+}
+
+// GetPolicy retrieves a policy by its ID.
+func (c *Client) GetPolicy(ctx context.Context, id int64) (*Policy, error) {
+	var policy Policy
+	if err := c.do(ctx, http.MethodGet, fmt.Sprintf("%s/%d", policyAPIPath, id), nil, nil, &policy); err != nil {
+		return nil, err
+	}
+	return &policy, nil
+}
+
+// GetPolicyByName retrieves a policy by service and name. Policies in a
+// security zone are only found when zone is set to the zone name.
+func (c *Client) GetPolicyByName(ctx context.Context, service, name, zone string) (*Policy, error) {
+	var query url.Values
+	if zone != "" {
+		query = url.Values{"zoneName": {zone}}
+	}
+
+	var policy Policy
+	path := fmt.Sprintf("/service/public/v2/api/service/%s/policy/%s", url.PathEscape(service), url.PathEscape(name))
+	if err := c.do(ctx, http.MethodGet, path, query, nil, &policy); err != nil {
+		return nil, err
+	}
+	return &policy, nil
+}
+
+// policyPageSize is the number of policies requested per page when listing.
+// It matches the default maximum page size of Ranger, which may be
+// configured lower.
+const policyPageSize = 200
+
+// maxPolicyPages bounds the number of pages requested when listing, so that
+// a server that keeps returning policies cannot make the listing run forever.
+const maxPolicyPages = 1000
+
+// ListPolicies returns the policies matching filter, requesting pages until
+// an empty one is returned, as the server may return fewer policies per page
+// than requested. Some Ranger versions ignore startIndex and return the same
+// policies for every page, so the listing also ends at a page that only
+// repeats policies already listed. When service is not empty the search is
+// scoped to that service.
+func (c *Client) ListPolicies(ctx context.Context, service string, filter url.Values) ([]Policy, error) {
+	path := policyAPIPath
+	if service != "" {
+		path = fmt.Sprintf("/service/public/v2/api/service/%s/policy", url.PathEscape(service))
+	}
+
+	query := make(url.Values, len(filter)+2)
+	for key, values := range filter {
+		query[key] = values
+	}
+	query.Set("pageSize", strconv.Itoa(policyPageSize))
+
+	var policies []Policy
+	listed := map[int64]bool{}
+	for pages := 0; pages < maxPolicyPages; pages++ {
+		query.Set("startIndex", strconv.Itoa(len(policies)))
+
+		var page []Policy
+		if err := c.do(ctx, http.MethodGet, path, query, nil, &page); err != nil {
+			return nil, err
+		}
+
+		added := 0
+		for _, policy := range page {
+			if listed[policy.ID] {
+				continue
+			}
+			listed[policy.ID] = true
+			policies = append(policies, policy)
+			added++
+		}
+		if added == 0 {
+			return policies, nil
+		}
+	}
+
+	return nil, fmt.Errorf("listing policies did not end after %d pages of %d policies", maxPolicyPages, policyPageSize)
+}
+
+// CreatePolicy creates a policy and returns it as stored by Ranger.
+func (c *Client) CreatePolicy(ctx context.Context, policy *Policy) (*Policy, error) {
+	var created Policy
+	if err := c.do(ctx, http.MethodPost, policyAPIPath, nil, policy, &created); err != nil {
+		return nil, err
+	}
+	return &created, nil
+}
+
+// UpdatePolicy replaces the policy with the given ID and returns it as stored by Ranger.
+func (c *Client) UpdatePolicy(ctx context.Context, id int64, policy *Policy) (*Policy, error) {
+	var updated Policy
+	if err := c.do(ctx, http.MethodPut, fmt.Sprintf("%s/%d", policyAPIPath, id), nil, policy, &updated); err != nil {
+		return nil, err
+	}
+	return &updated, nil
+}
+
+// DeletePolicy deletes the policy with the given ID.
+func (c *Client) DeletePolicy(ctx context.Context, id int64) error {
+	return c.do(ctx, http.MethodDelete, fmt.Sprintf("%s/%d", policyAPIPath, id), nil, nil, nil)
+}
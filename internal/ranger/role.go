@@ -0,0 +1,93 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ranger
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+const roleAPIPath = "/service/public/v2/api/roles"
+
+// Role represents the Apache Ranger role JSON structure. Roles group users,
+// groups and other roles, and policy items may grant access to them.
+type Role struct {
+	ID            int64                  `json:"id,omitempty"`
+	Name          string                 `json:"name"`
+	Description   string                 `json:"description,omitempty"`
+	IsEnabled     bool                   `json:"isEnabled"`
+	Options       map[string]interface{} `json:"options,omitempty"`
+	Users         []RoleMember           `json:"users"`
+	Groups        []RoleMember           `json:"groups"`
+	Roles         []RoleMember           `json:"roles"`
+	CreatedByUser string                 `json:"createdByUser,omitempty"`
+}
+
+// RoleMember is a user, group or role of a role. Admin members may manage
+// the membership of the role.
+type RoleMember struct {
+	Name    string `json:"name"`
+	IsAdmin bool   `json:"isAdmin"`
+}
+
+// GetRole retrieves a role by its ID.
+func (c *Client) GetRole(ctx context.Context, id int64) (*Role, error) {
+	var role Role
+	if err := c.do(ctx, http.MethodGet, fmt.Sprintf("%s/%d", roleAPIPath, id), nil, nil, &role); err != nil {
+		return nil, err
+	}
+	return &role, nil
+}
+
+// GetRoleByName retrieves a role by its name.
+func (c *Client) GetRoleByName(ctx context.Context, name string) (*Role, error) {
+	var role Role
+	if err := c.do(ctx, http.MethodGet, fmt.Sprintf("%s/name/%s", roleAPIPath, url.PathEscape(name)), nil, nil, &role); err != nil {
+		return nil, err
+	}
+	return &role, nil
+}
+
+// CreateRole creates a role and returns it as stored by Ranger.
+func (c *Client) CreateRole(ctx context.Context, role *Role) (*Role, error) {
+	var created Role
+	if err := c.do(ctx, http.MethodPost, roleAPIPath, nil, role, &created); err != nil {
+		return nil, err
+	}
+	return &created, nil
+}
+
+// UpdateRole replaces the role with the given ID and returns it as stored by Ranger.
+func (c *Client) UpdateRole(ctx context.Context, id int64, role *Role) (*Role, error) {
+	var updated Role
+	if err := c.do(ctx, http.MethodPut, fmt.Sprintf("%s/%d", roleAPIPath, id), nil, role, &updated); err != nil {
+		return nil, err
+	}
+	return &updated, nil
+}
+
+// DeleteRole deletes the role with the given ID.
+func (c *Client) DeleteRole(ctx context.Context, id int64) error {
+	return c.do(ctx, http.MethodDelete, fmt.Sprintf("%s/%d", roleAPIPath, id), nil, nil, nil)
+}
+
+// ListRoleNames returns the names of all roles.
+func (c *Client) ListRoleNames(ctx context.Context) ([]string, error) {
+	var names []string
+	if err := c.do(ctx, http.MethodGet, roleAPIPath+"/names", nil, nil, &names); err != nil {
+		return nil, err
+	}
+	return names, nil
+}
+
+// GetUserRoles returns the names of the roles Ranger resolves for the user.
+func (c *Client) GetUserRoles(ctx context.Context, userName string) ([]string, error) {
+	var names []string
+	if err := c.do(ctx, http.MethodGet, fmt.Sprintf("%s/user/%s", roleAPIPath, url.PathEscape(userName)), nil, nil, &names); err != nil {
+		return nil, err
+	}
+	return names, nil
+}
@@ -0,0 +1,74 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ranger
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+const serviceAPIPath = "/service/public/v2/api/service"
+
+// MaskedPassword is the placeholder Ranger returns in place of password-type
+// service configs. Sending it back on update keeps the stored value.
+const MaskedPassword = "*****"
+
+// Service represents the Apache Ranger service (repository) JSON structure.
+type Service struct {
+	ID               int64             `json:"id,omitempty"`
+	Name             string            `json:"name"`
+	DisplayName      string            `json:"displayName,omitempty"`
+	Type             string            `json:"type"`
+	Description      string            `json:"description,omitempty"`
+	IsEnabled        bool              `json:"isEnabled"`
+	TagService       string            `json:"tagService,omitempty"`
+	Configs          map[string]string `json:"configs,omitempty"`
+	PolicyVersion    int64             `json:"policyVersion,omitempty"`
+	PolicyUpdateTime int64             `json:"policyUpdateTime,omitempty"`
+	TagVersion       int64             `json:"tagVersion,omitempty"`
+	TagUpdateTime    int64             `json:"tagUpdateTime,omitempty"`
+}
+
+// GetService retrieves a service by its ID.
+func (c *Client) GetService(ctx context.Context, id int64) (*Service, error) {
+	var service Service
+	if err := c.do(ctx, http.MethodGet, fmt.Sprintf("%s/%d", serviceAPIPath, id), nil, nil, &service); err != nil {
+		return nil, err
+	}
+	return &service, nil
+}
+
+// GetServiceByName retrieves a service by its name.
+func (c *Client) GetServiceByName(ctx context.Context, name string) (*Service, error) {
+	var service Service
+	if err := c.do(ctx, http.MethodGet, fmt.Sprintf("%s/name/%s", serviceAPIPath, url.PathEscape(name)), nil, nil, &service); err != nil {
+		return nil, err
+	}
+	return &service, nil
+}
+
+// CreateService creates a service and returns it as stored by Ranger.
+func (c *Client) CreateService(ctx context.Context, service *Service) (*Service, error) {
+	var created Service
+	if err := c.do(ctx, http.MethodPost, serviceAPIPath, nil, service, &created); err != nil {
+		return nil, err
+	}
+	return &created, nil
+}
+
+// UpdateService replaces the service with the given ID and returns it as stored by Ranger.
+func (c *Client) UpdateService(ctx context.Context, id int64, service *Service) (*Service, error) {
+	var updated Service
+	if err := c.do(ctx, http.MethodPut, fmt.Sprintf("%s/%d", serviceAPIPath, id), nil, service, &updated); err != nil {
+		return nil, err
+	}
+	return &updated, nil
+}
+
+// DeleteService deletes the service with the given ID.
+func (c *Client) DeleteService(ctx context.Context, id int64) error {
+	return c.do(ctx, http.MethodDelete, fmt.Sprintf("%s/%d", serviceAPIPath, id), nil, nil, nil)
+}
@@ -0,0 +1,189 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ranger
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+const serviceDefAPIPath = "/service/public/v2/api/servicedef"
+
+// ServiceDef represents the Apache Ranger service definition JSON structure.
+// A service-def describes a service type (hive, hdfs, ...) and what its
+// policies may contain.
+type ServiceDef struct {
+	ID               int64                `json:"id,omitempty"`
+	Name             string               `json:"name"`
+	DisplayName      string               `json:"displayName,omitempty"`
+	ImplClass        string               `json:"implClass,omitempty"`
+	Label            string               `json:"label,omitempty"`
+	Description      string               `json:"description,omitempty"`
+	Options          map[string]string    `json:"options,omitempty"`
+	Configs          []ServiceConfigDef   `json:"configs,omitempty"`
+	Resources        []ResourceDef        `json:"resources,omitempty"`
+	AccessTypes      []AccessTypeDef      `json:"accessTypes,omitempty"`
+	PolicyConditions []PolicyConditionDef `json:"policyConditions,omitempty"`
+	ContextEnrichers []ContextEnricherDef `json:"contextEnrichers,omitempty"`
+	Enums            []EnumDef            `json:"enums,omitempty"`
+	DataMaskDef      *DataMaskDef         `json:"dataMaskDef,omitempty"`
+	RowFilterDef     *RowFilterDef        `json:"rowFilterDef,omitempty"`
+}
+
+// ServiceConfigDef describes a configuration property of the services of a
+// service type (username, jdbc.url, ...).
+type ServiceConfigDef struct {
+	ItemID            int64  `json:"itemId"`
+	Name              string `json:"name"`
+	Type              string `json:"type"`
+	SubType           string `json:"subType,omitempty"`
+	Mandatory         bool   `json:"mandatory"`
+	DefaultValue      string `json:"defaultValue,omitempty"`
+	ValidationRegEx   string `json:"validationRegEx,omitempty"`
+	ValidationMessage string `json:"validationMessage,omitempty"`
+	UIHint            string `json:"uiHint,omitempty"`
+	Label             string `json:"label,omitempty"`
+	Description       string `json:"description,omitempty"`
+}
+
+// EnumDef describes a set of named values that enum-typed configs refer to
+// through their subType.
+type EnumDef struct {
+	ItemID       int64            `json:"itemId"`
+	Name         string           `json:"name"`
+	Elements     []EnumElementDef `json:"elements"`
+	DefaultIndex int64            `json:"defaultIndex"`
+}
+
+// EnumElementDef is a value of an enum.
+type EnumElementDef struct {
+	ItemID int64  `json:"itemId"`
+	Name   string `json:"name"`
+	Label  string `json:"label,omitempty"`
+}
+
+// ContextEnricherDef describes a plugin component adding information (tags,
+// geolocation, ...) to access requests before policies are evaluated.
+type ContextEnricherDef struct {
+	ItemID          int64             `json:"itemId"`
+	Name            string            `json:"name"`
+	Enricher        string            `json:"enricher"`
+	EnricherOptions map[string]string `json:"enricherOptions,omitempty"`
+}
+
+// ResourceDef describes a resource component (database, table, path, ...)
+// that policies of a service type protect. Resources form a hierarchy
+// through their parent and level. IsValidLeaf is derived by Ranger from the
+// hierarchy when it is not set.
+type ResourceDef struct {
+	ItemID                 int64             `json:"itemId"`
+	Name                   string            `json:"name"`
+	Type                   string            `json:"type,omitempty"`
+	Level                  int64             `json:"level"`
+	Parent                 string            `json:"parent,omitempty"`
+	Mandatory              bool              `json:"mandatory"`
+	LookupSupported        bool              `json:"lookupSupported"`
+	RecursiveSupported     bool              `json:"recursiveSupported"`
+	ExcludesSupported      bool              `json:"excludesSupported"`
+	Matcher                string            `json:"matcher,omitempty"`
+	MatcherOptions         map[string]string `json:"matcherOptions,omitempty"`
+	ValidationRegEx        string            `json:"validationRegEx,omitempty"`
+	ValidationMessage      string            `json:"validationMessage,omitempty"`
+	UIHint                 string            `json:"uiHint,omitempty"`
+	Label                  string            `json:"label,omitempty"`
+	Description            string            `json:"description,omitempty"`
+	AccessTypeRestrictions []string          `json:"accessTypeRestrictions,omitempty"`
+	IsValidLeaf            *bool             `json:"isValidLeaf,omitempty"`
+}
+
+// AccessTypeDef describes a permission that policy items of a service type
+// may grant. Granting it also grants its implied grants.
+type AccessTypeDef struct {
+	ItemID        int64    `json:"itemId"`
+	Name          string   `json:"name"`
+	Label         string   `json:"label,omitempty"`
+	ImpliedGrants []string `json:"impliedGrants,omitempty"`
+}
+
+// PolicyConditionDef describes a condition that policy items of a service
+// type may use.
+type PolicyConditionDef struct {
+	ItemID           int64             `json:"itemId"`
+	Name             string            `json:"name"`
+	Label            string            `json:"label,omitempty"`
+	Description      string            `json:"description,omitempty"`
+	Evaluator        string            `json:"evaluator,omitempty"`
+	EvaluatorOptions map[string]string `json:"evaluatorOptions,omitempty"`
+}
+
+// DataMaskDef describes the data masking policies of a service type. Its
+// access types and resources replace those of the service-def for them.
+type DataMaskDef struct {
+	MaskTypes   []DataMaskTypeDef `json:"maskTypes,omitempty"`
+	AccessTypes []AccessTypeDef   `json:"accessTypes,omitempty"`
+	Resources   []ResourceDef     `json:"resources,omitempty"`
+}
+
+// DataMaskTypeDef describes a way of masking a value (MASK, MASK_HASH, ...).
+type DataMaskTypeDef struct {
+	ItemID          int64             `json:"itemId"`
+	Name            string            `json:"name"`
+	Label           string            `json:"label,omitempty"`
+	Description     string            `json:"description,omitempty"`
+	Transformer     string            `json:"transformer,omitempty"`
+	DataMaskOptions map[string]string `json:"dataMaskOptions,omitempty"`
+}
+
+// RowFilterDef describes the row filter policies of a service type. Its
+// access types and resources replace those of the service-def for them.
+type RowFilterDef struct {
+	AccessTypes []AccessTypeDef `json:"accessTypes,omitempty"`
+	Resources   []ResourceDef   `json:"resources,omitempty"`
+}
+
+// GetServiceDef retrieves a service definition by its ID.
+func (c *Client) GetServiceDef(ctx context.Context, id int64) (*ServiceDef, error) {
+	var serviceDef ServiceDef
+	if err := c.do(ctx, http.MethodGet, fmt.Sprintf("%s/%d", serviceDefAPIPath, id), nil, nil, &serviceDef); err != nil {
+		return nil, err
+	}
+	return &serviceDef, nil
+}
+
+// GetServiceDefByName retrieves a service definition by its name, which is
+// the type of the services using it.
+func (c *Client) GetServiceDefByName(ctx context.Context, name string) (*ServiceDef, error) {
+	var serviceDef ServiceDef
+	if err := c.do(ctx, http.MethodGet, fmt.Sprintf("%s/name/%s", serviceDefAPIPath, url.PathEscape(name)), nil, nil, &serviceDef); err != nil {
+		return nil, err
+	}
+	return &serviceDef, nil
+}
+
+// CreateServiceDef creates a service definition and returns it as stored by Ranger.
+func (c *Client) CreateServiceDef(ctx context.Context, serviceDef *ServiceDef) (*ServiceDef, error) {
+	var created ServiceDef
+	if err := c.do(ctx, http.MethodPost, serviceDefAPIPath, nil, serviceDef, &created); err != nil {
+		return nil, err
+	}
+	return &created, nil
+}
+
+// UpdateServiceDef replaces the service definition with the given ID and
+// returns it as stored by Ranger.
+func (c *Client) UpdateServiceDef(ctx context.Context, id int64, serviceDef *ServiceDef) (*ServiceDef, error) {
+	var updated ServiceDef
+	if err := c.do(ctx, http.MethodPut, fmt.Sprintf("%s/%d", serviceDefAPIPath, id), nil, serviceDef, &updated); err != nil {
+		return nil, err
+	}
+	return &updated, nil
+}
+
+// DeleteServiceDef deletes the service definition with the given ID. Ranger
+// refuses to delete a service definition that services still use.
+func (c *Client) DeleteServiceDef(ctx context.Context, id int64) error {
+	return c.do(ctx, http.MethodDelete, fmt.Sprintf("%s/%d", serviceDefAPIPath, id), nil, nil, nil)
+}
@@ -0,0 +1,82 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ranger
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// tagAPIPath is the base path of Ranger's TagREST API. The tag definition
+// endpoints below follow TagREST in Apache Ranger 2.4, where /tagdef/ serves a
+// single tag definition and /tagdefs/ only lists them.
+const tagAPIPath = "/service/tags"
+
+// TagDef represents the Apache Ranger tag definition (RangerTagDef) JSON
+// structure. Tag definitions are usually synchronized from Atlas by tagsync;
+// tag-based policies grant access to the resources tagged with them.
+type TagDef struct {
+	ID            int64             `json:"id,omitempty"`
+	GUID          string            `json:"guid,omitempty"`
+	IsEnabled     bool              `json:"isEnabled"`
+	Version       int64             `json:"version,omitempty"`
+	Name          string            `json:"name"`
+	Source        string            `json:"source,omitempty"`
+	AttributeDefs []TagAttributeDef `json:"attributeDefs"`
+}
+
+// TagAttributeDef describes an attribute of a tag, such as the expiry date
+// of a PII tag.
+type TagAttributeDef struct {
+	Name string `json:"name"`
+	Type string `json:"type"`
+}
+
+// GetTagDef retrieves a tag definition by its ID.
+func (c *Client) GetTagDef(ctx context.Context, id int64) (*TagDef, error) {
+	var tagDef TagDef
+	if err := c.do(ctx, http.MethodGet, fmt.Sprintf("%s/tagdef/%d", tagAPIPath, id), nil, nil, &tagDef); err != nil {
+		return nil, err
+	}
+	return &tagDef, nil
+}
+
+// GetTagDefByName retrieves a tag definition by its name.
+func (c *Client) GetTagDefByName(ctx context.Context, name string) (*TagDef, error) {
+	var tagDef TagDef
+	if err := c.do(ctx, http.MethodGet, fmt.Sprintf("%s/tagdef/name/%s", tagAPIPath, url.PathEscape(name)), nil, nil, &tagDef); err != nil {
+		return nil, err
+	}
+	return &tagDef, nil
+}
+
+// CreateTagDef creates a tag definition and returns it as stored by Ranger
+// (POST /service/tags/tagdef). updateIfExists is false, so creating a tag
+// definition that already exists fails rather than overwriting it.
+func (c *Client) CreateTagDef(ctx context.Context, tagDef *TagDef) (*TagDef, error) {
+	var created TagDef
+	query := url.Values{"updateIfExists": {"false"}}
+	if err := c.do(ctx, http.MethodPost, tagAPIPath+"/tagdef", query, tagDef, &created); err != nil {
+		return nil, err
+	}
+	return &created, nil
+}
+
+// UpdateTagDef replaces the tag definition with the given ID and returns it
+// as stored by Ranger (PUT /service/tags/tagdef/{id}).
+func (c *Client) UpdateTagDef(ctx context.Context, id int64, tagDef *TagDef) (*TagDef, error) {
+	var updated TagDef
+	if err := c.do(ctx, http.MethodPut, fmt.Sprintf("%s/tagdef/%d", tagAPIPath, id), nil, tagDef, &updated); err != nil {
+		return nil, err
+	}
+	return &updated, nil
+}
+
+// DeleteTagDef deletes the tag definition with the given ID. Ranger refuses
+// to delete a tag definition while tags of it exist.
+func (c *Client) DeleteTagDef(ctx context.Context, id int64) error {
+	return c.do(ctx, http.MethodDelete, fmt.Sprintf("%s/tagdef/%d", tagAPIPath, id), nil, nil, nil)
+}
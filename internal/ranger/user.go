@@ -0,0 +1,90 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ranger
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+const xUsersAPIPath = "/service/xusers"
+
+// User statuses.
+const (
+	UserStatusDisabled int64 = 0
+	UserStatusEnabled  int64 = 1
+)
+
+// Sources of users and groups. External ones are synchronized by usersync
+// from LDAP/AD or Unix.
+const (
+	SourceInternal int64 = 0
+	SourceExternal int64 = 1
+)
+
+// User represents the Apache Ranger user (VXUser) JSON structure.
+type User struct {
+	ID           int64  `json:"id,omitempty"`
+	Name         string `json:"name"`
+	FirstName    string `json:"firstName,omitempty"`
+	LastName     string `json:"lastName,omitempty"`
+	EmailAddress string `json:"emailAddress,omitempty"`
+	// Password is only sent to Ranger. Ranger returns it masked, and keeps
+	// the stored password when an update omits it.
+	Password      string   `json:"password,omitempty"`
+	Description   string   `json:"description,omitempty"`
+	Status        int64    `json:"status"`
+	IsVisible     int64    `json:"isVisible,omitempty"`
+	UserSource    int64    `json:"userSource"`
+	UserRoleList  []string `json:"userRoleList,omitempty"`
+	GroupIDList   []int64  `json:"groupIdList,omitempty"`
+	GroupNameList []string `json:"groupNameList,omitempty"`
+	SyncSource    string   `json:"syncSource,omitempty"`
+}
+
+// GetUser retrieves a user by its ID.
+func (c *Client) GetUser(ctx context.Context, id int64) (*User, error) {
+	var user User
+	if err := c.do(ctx, http.MethodGet, fmt.Sprintf("%s/users/%d", xUsersAPIPath, id), nil, nil, &user); err != nil {
+		return nil, err
+	}
+	return &user, nil
+}
+
+// GetUserByName retrieves a user by its name.
+func (c *Client) GetUserByName(ctx context.Context, name string) (*User, error) {
+	var user User
+	if err := c.do(ctx, http.MethodGet, fmt.Sprintf("%s/users/userName/%s", xUsersAPIPath, url.PathEscape(name)), nil, nil, &user); err != nil {
+		return nil, err
+	}
+	return &user, nil
+}
+
+// CreateUser creates an internal user and returns it as stored by Ranger.
+func (c *Client) CreateUser(ctx context.Context, user *User) (*User, error) {
+	var created User
+	if err := c.do(ctx, http.MethodPost, xUsersAPIPath+"/secure/users", nil, user, &created); err != nil {
+		return nil, err
+	}
+	return &created, nil
+}
+
+// UpdateUser replaces the user with the given ID and returns it as stored by
+// Ranger. The group memberships are replaced by GroupIDList.
+func (c *Client) UpdateUser(ctx context.Context, id int64, user *User) (*User, error) {
+	var updated User
+	if err := c.do(ctx, http.MethodPut, fmt.Sprintf("%s/secure/users/%d", xUsersAPIPath, id), nil, user, &updated); err != nil {
+		return nil, err
+	}
+	return &updated, nil
+}
+
+// DeleteUser deletes the user with the given ID. Without forceDelete Ranger
+// only hides the user, keeping the name taken.
+func (c *Client) DeleteUser(ctx context.Context, id int64) error {
+	query := url.Values{"forceDelete": []string{"true"}}
+	return c.do(ctx, http.MethodDelete, fmt.Sprintf("%s/secure/users/id/%d", xUsersAPIPath, id), query, nil, nil)
+}
@@ -28,18 +28,19 @@ resource "ranger_policy" "test_policy" {
   description = "Test policy for validation"
   is_enabled  = true
 
-  resources {
-    type        = "path"
-    values      = ["/data/test"]
-    is_exclude  = false
-    is_recursive = true
+  resources = {
+    path = {
+      values       = ["/data/test"]
+      is_exclude   = false
+      is_recursive = true
+    }
   }
 
-  policy_item {
-    users         = []
-    groups        = ["test_group"]
-    roles         = []
-    permissions   = ["read", "write"]
-    delegate_admin = false
-  }
-} 
\ No newline at end of file
+  policy_item = [
+    {
+      groups         = ["test_group"]
+      permissions    = ["read", "write"]
+      delegate_admin = false
+    },
+  ]
+}